@@ -0,0 +1,370 @@
+package casefolded
+
+import (
+	"cmp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/fvbommel/sortorder/internal/rank"
+	"github.com/fvbommel/sortorder/internal/sortkey"
+)
+
+//go:generate go run maketables.go
+
+// A Folding is a set of case folding rules used to compare strings.
+// Its methods compare in case-folded natural order, like the package-level
+// functions but with different rules.
+// Method values like Full.NaturalCompare can be passed to functions like
+// slices.SortFunc directly.
+type Folding uint8
+
+const (
+	// Simple folding maps every rune to a single rune, like NaturalLess does.
+	// For example, "abc2" < "ABC12" and 'k' == 'K' (the Kelvin symbol).
+	Simple Folding = 0
+
+	// Full folding also applies the foldings that map a rune to several
+	// runes (those with status F in CaseFolding.txt).
+	// For example, "Straße 2" < "STRASSE 10" and 'ﬀ' == "FF".
+	// Numbers are still compared numerically, regardless of the lengths of
+	// the foldings before them.
+	Full Folding = 1 << iota
+
+	// Turkish folding applies the foldings for Turkish and Azerbaijani
+	// (those with status T in CaseFolding.txt), so 'I' == 'ı' and 'İ' == 'i',
+	// but 'I' != 'i'. It can be combined with Full, as in Full|Turkish.
+	//
+	// As in the Turkish alphabet, dotless 'ı' sorts before dotted 'i': the
+	// latter is compared as 'I' followed by U+0307 COMBINING DOT ABOVE.
+	// For example, "Isparta" < "İstanbul 2" < "istanbul 10" < "İzmir".
+	Turkish
+
+	// LowerFirst breaks ties between strings that are equal under folding,
+	// so that only identical strings compare as equal. The strings are
+	// compared rune by rune, with lower-case letters before all other runes
+	// and runes of the same kind by code point. If that doesn't decide
+	// either, as can happen with invalid UTF-8, the strings are compared
+	// bytewise.
+	// For example, "abc1" < "Abc1" < "ABC1" < "abc2".
+	LowerFirst
+
+	// UpperFirst breaks ties like LowerFirst, except that upper-case and
+	// title-case letters come before all other runes.
+	// For example, "ABC1" < "Abc1" < "abc1" < "abc2".
+	// If combined with LowerFirst, it has no effect.
+	UpperFirst
+
+	// Width folding maps fullwidth and halfwidth forms to their normal forms
+	// before case folding, so 'Ａ' == 'a', '　' == ' ' and 'ｶ' == 'カ'.
+	// Fullwidth digits are part of numbers, so "file2" < "ＦＩＬＥ２" <
+	// "file10". A halfwidth katakana followed by a halfwidth voiced or
+	// semi-voiced sound mark is compared like the precomposed katakana, so
+	// "ｶﾞ" == "ガ". (Collators of the collate package do the same with
+	// Normalize(Compatibility) and UnicodeDigits.)
+	Width
+)
+
+// tieBreaks holds the flags that break ties.
+const tieBreaks = LowerFirst | UpperFirst
+
+// NaturalLess compares two strings using case-folded natural ordering
+// with the folding rules of f. See NaturalLess for details.
+func (f Folding) NaturalLess(str1, str2 string) bool {
+	return f.NaturalCompare(str1, str2) < 0
+}
+
+// NaturalCompare compares two strings using case-folded natural ordering
+// with the folding rules of f, like f.NaturalLess.
+// The result will be 0 if str1 and str2 are equivalent, -1 if str1 < str2,
+// and +1 if str1 > str2.
+func (f Folding) NaturalCompare(str1, str2 string) int {
+	return f.compare(str1, str2, nil)
+}
+
+// compare compares two strings like f.NaturalCompare, ordering letters by
+// their script first if scripts is not empty.
+func (f Folding) compare(str1, str2 string, scripts []*unicode.RangeTable) int {
+	res := f.compareFolded(str1, str2, scripts)
+	if res == 0 && f&tieBreaks != 0 {
+		return f.compareCase(str1, str2)
+	}
+	return res
+}
+
+// compareFolded compares two strings after folding them, ignoring f's
+// tie-breaking flags.
+func (f Folding) compareFolded(str1, str2 string, scripts []*unicode.RangeTable) int {
+	if len(scripts) == 0 && f&^(Turkish|tieBreaks) == Simple && (f&Turkish == 0 || !hasTurkishI(str1) && !hasTurkishI(str2)) {
+		return NaturalCompare(str1, str2)
+	}
+	it1, it2 := f.iter(str1), f.iter(str2)
+	for !it1.done() && !it2.done() {
+		dig1, dig2 := it1.atDigit(), it2.atDigit()
+		switch {
+		case dig1 != dig2: // Digits before other characters.
+			if dig1 { // LHS is a digit, so it's less.
+				return -1
+			}
+			return +1
+		case !dig1: // && !dig2, because dig1 == dig2
+			if c1, c2 := it1.next(), it2.next(); c1 != c2 {
+				if rank1, rank2 := rank.Script(scripts, c1), rank.Script(scripts, c2); rank1 != rank2 {
+					return cmp.Compare(rank1, rank2)
+				}
+				return cmp.Compare(c1, c2)
+			}
+		default: // Digits
+			nr1, zeros1 := it1.number()
+			nr2, zeros2 := it2.number()
+			// If lengths of numbers with non-zero prefix differ, the shorter
+			// one is less.
+			if len1, len2 := len(nr1), len(nr2); len1 != len2 {
+				return cmp.Compare(len1, len2)
+			}
+			// If they're equally long, string comparison is correct.
+			if nr1 != nr2 {
+				return cmp.Compare(nr1, nr2)
+			}
+			// Otherwise, the one with less zeros is less.
+			if zeros1 != zeros2 {
+				return cmp.Compare(zeros1, zeros2)
+			}
+		}
+		// They're identical so far, so continue comparing.
+	}
+	// So far they are identical. At least one is ended. If the other continues,
+	// it sorts last.
+	switch {
+	case !it1.done():
+		return +1
+	case !it2.done():
+		return -1
+	}
+	return 0
+}
+
+// compareCase breaks the tie between two strings that are equal under
+// folding, as described for LowerFirst and UpperFirst.
+func (f Folding) compareCase(str1, str2 string) int {
+	for idx1, idx2 := 0, 0; idx1 < len(str1) && idx2 < len(str2); {
+		r1, size1 := utf8.DecodeRuneInString(str1[idx1:])
+		r2, size2 := utf8.DecodeRuneInString(str2[idx2:])
+		if r1 != r2 {
+			if rank1, rank2 := f.caseRank(r1), f.caseRank(r2); rank1 != rank2 {
+				return cmp.Compare(rank1, rank2)
+			}
+			return cmp.Compare(r1, r2)
+		}
+		idx1 += size1
+		idx2 += size2
+	}
+	// If the runes of one string are a prefix of those of the other, the
+	// shorter one is less.
+	if n1, n2 := utf8.RuneCountInString(str1), utf8.RuneCountInString(str2); n1 != n2 {
+		return cmp.Compare(n1, n2)
+	}
+	return cmp.Compare(str1, str2)
+}
+
+// caseRank returns 0 for runes that come first when breaking ties,
+// and 1 for all others.
+func (f Folding) caseRank(r rune) int {
+	return rank.Case(r, f&LowerFirst != 0)
+}
+
+// Key returns a case-folded sort key for s, using the folding rules of f.
+// See AppendKey for details.
+func (f Folding) Key(s string) []byte {
+	return f.AppendKey(nil, s)
+}
+
+// AppendKey appends a case-folded sort key for s to dst, using the folding
+// rules of f, and returns the extended buffer.
+// For any strings a and b, bytes.Compare(f.Key(a), f.Key(b)) ==
+// f.NaturalCompare(a, b). The key format is that of AppendKey, except that
+// runes are folded according to f, so keys created with different foldings
+// must not be compared with each other. With LowerFirst or UpperFirst, the
+// key is followed by a 0x00 byte and the information needed to break ties.
+func (f Folding) AppendKey(dst []byte, s string) []byte {
+	return f.appendKey(dst, s, nil)
+}
+
+// appendKey appends the key of s like f.AppendKey, ordering letters by
+// their script first if scripts is not empty.
+func (f Folding) appendKey(dst []byte, s string, scripts []*unicode.RangeTable) []byte {
+	dst = f.appendFoldedKey(dst, s, scripts)
+	if f&tieBreaks == 0 {
+		return dst
+	}
+	// Ties are broken by the ranks and code points of the runes, and
+	// finally by the bytes of the string.
+	dst = append(dst, keyLevel)
+	for _, r := range s {
+		var buf [utf8.UTFMax]byte
+		dst = append(dst, byte(f.caseRank(r)+1))
+		dst = append(dst, buf[:utf8.EncodeRune(buf[:], r)]...)
+	}
+	dst = append(dst, keyLevel)
+	return append(dst, s...)
+}
+
+// appendFoldedKey appends the key of s, ignoring f's tie-breaking flags.
+// With scripts, every rune is preceded by the rank of its script.
+func (f Folding) appendFoldedKey(dst []byte, s string, scripts []*unicode.RangeTable) []byte {
+	it := f.iter(s)
+	for !it.done() {
+		if !it.atDigit() {
+			r := it.next()
+			if len(scripts) > 0 {
+				dst = append(dst, byte(rank.Script(scripts, r)+2))
+			}
+			var buf [utf8.UTFMax]byte
+			for _, b := range buf[:utf8.EncodeRune(buf[:], r)] {
+				dst = append(dst, b+2)
+			}
+			continue
+		}
+		nr, zeros := it.number()
+		dst = sortkey.AppendNumber(dst, nr, zeros)
+	}
+	return dst
+}
+
+// A foldIter iterates over the case-folded runes of a string.
+type foldIter struct {
+	f   Folding
+	str string
+	idx int
+	// pending holds the rest of a folding that maps to several runes.
+	pending string
+}
+
+func (f Folding) iter(str string) foldIter {
+	return foldIter{f: f, str: str}
+}
+
+// done reports whether all runes have been returned.
+func (it *foldIter) done() bool {
+	return it.pending == "" && it.idx >= len(it.str)
+}
+
+// atDigit reports whether the next rune is a digit.
+// Foldings never contain digits, so only the string itself is checked.
+func (it *foldIter) atDigit() bool {
+	d, _ := it.digitAt(it.idx)
+	return it.pending == "" && d >= 0
+}
+
+// digitAt returns the value and size of the digit at idx, or -1 if there is
+// none. With Width, fullwidth digits are digits too.
+func (it *foldIter) digitAt(idx int) (d, size int) {
+	switch {
+	case idx >= len(it.str):
+		return -1, 0
+	case isDigit(rune(it.str[idx])):
+		return int(it.str[idx] - '0'), 1
+	case it.f&Width != 0 && it.str[idx] >= utf8.RuneSelf:
+		if r, size := utf8.DecodeRuneInString(it.str[idx:]); '０' <= r && r <= '９' {
+			return int(r - '０'), size
+		}
+	}
+	return -1, 0
+}
+
+// next returns the next folded rune, which is the lowest rune equivalent to
+// the next rune of the (fully) folded string.
+func (it *foldIter) next() rune {
+	if it.pending != "" {
+		r, size := utf8.DecodeRuneInString(it.pending)
+		it.pending = it.pending[size:]
+		if r == 'i' && it.f&Turkish != 0 {
+			// Part of a full folding, like that of 'ﬁ'.
+			it.pending = dotAbove + it.pending
+		}
+		return caseFold(r)
+	}
+	r, size := rune(it.str[it.idx]), 1
+	if r >= utf8.RuneSelf {
+		r, size = utf8.DecodeRuneInString(it.str[it.idx:])
+		if it.f&Width != 0 {
+			r, size = it.foldWidth(r, size)
+		}
+	}
+	it.idx += size
+	if r < utf8.RuneSelf {
+		if r == 'i' && it.f&Turkish != 0 {
+			it.pending = dotAbove
+		}
+		// Upper-cased ASCII is the lowest equivalent.
+		return unicode.ToUpper(r)
+	}
+	if it.f&Turkish != 0 {
+		switch r {
+		case 'ı':
+			return 'I'
+		case 'İ':
+			it.pending = dotAbove
+			return 'I'
+		}
+	}
+	if it.f&Full != 0 {
+		if folded := fullFold(r); folded != "" {
+			r, size = utf8.DecodeRuneInString(folded)
+			it.pending = folded[size:]
+		}
+	}
+	return caseFold(r)
+}
+
+// foldWidth returns the normal form of the rune r of the given size at the
+// current position, and the number of bytes it takes up, which includes a
+// halfwidth sound mark after a halfwidth katakana.
+func (it *foldIter) foldWidth(r rune, size int) (rune, int) {
+	folded := widthFold(r)
+	if folded == 0 {
+		return r, size
+	}
+	if mark, markSize := utf8.DecodeRuneInString(it.str[it.idx+size:]); mark == 'ﾞ' || mark == 'ﾟ' {
+		if composed := soundMark(folded, widthFold(mark)); composed != 0 {
+			return composed, size + markSize
+		}
+	}
+	return folded, size
+}
+
+// dotAbove follows 'I' in the Turkish folding of dotted 'i' and 'İ'.
+const dotAbove = "\u0307"
+
+// number consumes the number at the current position, and returns its
+// significant digits and the number of leading zeros.
+// Fullwidth digits are returned as ASCII digits.
+func (it *foldIter) number() (digits string, zeros int) {
+	// Eat zeros.
+	d, size := it.digitAt(it.idx)
+	for ; d == 0; d, size = it.digitAt(it.idx) {
+		it.idx += size
+		zeros++
+	}
+	// Eat all digits.
+	nonZero, ascii := it.idx, true
+	for ; d >= 0; d, size = it.digitAt(it.idx) {
+		it.idx += size
+		ascii = ascii && size == 1
+	}
+	if ascii {
+		return it.str[nonZero:it.idx], zeros
+	}
+	buf := make([]byte, 0, it.idx-nonZero)
+	for idx := nonZero; idx < it.idx; idx += size {
+		d, size = it.digitAt(idx)
+		buf = append(buf, byte('0'+d))
+	}
+	return string(buf), zeros
+}
+
+// hasTurkishI reports whether s contains a character that Turkish folding
+// treats differently.
+func hasTurkishI(s string) bool {
+	return strings.ContainsAny(s, "iIİı")
+}
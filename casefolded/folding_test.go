@@ -0,0 +1,208 @@
+package casefolded
+
+import (
+	"bytes"
+	"cmp"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/fvbommel/sortorder/internal/testgen"
+)
+
+func TestFullNaturalLess(t *testing.T) {
+	testset := []struct {
+		s1, s2 string
+		cmp    int
+	}{
+		{"Straße 2", "STRASSE 10", -1},
+		{"STRASSE 10", "Straße 2", +1},
+		{"straße", "STRASSE", 0},
+		{"straße", "strasse", 0},
+		{"STRAẞE", "strasse", 0},
+		{"ﬀ", "FF", 0},
+		{"ﬀ2", "ff10", -1},
+		{"ﬃx", "ffix", 0},
+		{"ß1", "ss01", -1},
+		{"ss01", "ß1", +1},
+		{"ßa", "ssb", -1},
+		{"ß", "s", +1},
+		{"ß", "st", -1},
+		{"ᾳ", "αι", 0},
+		{"ᾼ2", "ΑΙ10", -1},
+		// Simple foldings still apply.
+		{"K", "k", 0},
+		{"abc2", "ABC12", -1},
+		// Foldings never contain digits.
+		{"ß2", "ss", +1},
+		{"ss", "ß2", -1},
+	}
+	for _, v := range testset {
+		if got := Full.NaturalCompare(v.s1, v.s2); got != v.cmp {
+			t.Errorf("Compared %+q to %+q: expected %v, got %v",
+				v.s1, v.s2, v.cmp, got)
+		}
+		if got := Full.NaturalLess(v.s1, v.s2); got != (v.cmp < 0) {
+			t.Errorf("Less(%+q, %+q): expected %v, got %v",
+				v.s1, v.s2, v.cmp < 0, got)
+		}
+	}
+}
+
+func TestTurkishNaturalLess(t *testing.T) {
+	testset := []struct {
+		f      Folding
+		s1, s2 string
+		cmp    int
+	}{
+		{Turkish, "İstanbul 2", "istanbul 10", -1},
+		{Turkish, "istanbul 10", "İstanbul 2", +1},
+		{Turkish, "İSTANBUL", "istanbul", 0},
+		{Turkish, "ILIK", "ılık", 0},
+		{Turkish, "I", "i", -1},
+		{Turkish, "ı", "İ", -1},
+		{Turkish, "Isparta", "İzmir", -1},
+		{Turkish, "istanbul 10", "İzmir", -1},
+		{Turkish, "ılık", "ilik", -1},
+		{Turkish, "Iz", "i", -1},
+		{Turkish, "İ", "J", -1},
+		{Turkish, "i2", "i10", -1},
+		{Turkish, "ı2", "i1", -1},
+		// Canonically equivalent to 'İ'.
+		{Turkish, "I\u0307", "i", 0},
+		// No dotted or dotless I.
+		{Turkish, "abc2", "ABC12", -1},
+		{Turkish, "K", "k", 0},
+		// Turkish folding takes precedence over full folding.
+		{Full | Turkish, "İ", "i", 0},
+		{Full | Turkish, "ß", "SS", 0},
+		{Full | Turkish, "ﬁ2", "Fİ10", -1},
+		{Full | Turkish, "ﬁ", "FI", +1},
+		{Full, "İ", "i\u0307", 0},
+		{Simple, "I", "i", 0},
+		{Simple, "ı", "I", +1},
+	}
+	for _, v := range testset {
+		if got := v.f.NaturalCompare(v.s1, v.s2); got != v.cmp {
+			t.Errorf("Folding %v: compared %+q to %+q: expected %v, got %v",
+				v.f, v.s1, v.s2, v.cmp, got)
+		}
+	}
+}
+
+func TestTieBreaks(t *testing.T) {
+	testset := []struct {
+		f    Folding
+		want []string
+	}{
+		{LowerFirst, []string{"abc1", "abC1", "aBc1", "Abc1", "ABC1", "abc01", "abc2", "ABC2"}},
+		{UpperFirst, []string{"ABC1", "Abc1", "aBc1", "abC1", "abc1", "ABC01", "ABC2", "abc2"}},
+		{Full | LowerFirst, []string{"strasse", "straße", "STRASSE", "STRASSE2"}},
+		{Full | UpperFirst, []string{"STRASSE", "strasse", "straße", "STRASSE2"}},
+		{LowerFirst | UpperFirst, []string{"abc", "Abc", "ABC"}},
+		// Invalid UTF-8 is compared bytewise.
+		{LowerFirst, []string{"a", "A", "a\xfe", "a\xff"}},
+	}
+	for _, v := range testset {
+		for i := range v.want {
+			for j := range v.want {
+				if got, want := v.f.NaturalCompare(v.want[i], v.want[j]), cmp.Compare(i, j); got != want {
+					t.Errorf("Folding %v: compared %+q to %+q: expected %v, got %v",
+						v.f, v.want[i], v.want[j], want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestSimpleFolding(t *testing.T) {
+	gen := &generator{src: rand.New(rand.NewSource(300))}
+	for i := 0; i < 10000; i++ {
+		s1, s2 := gen.NextString(), gen.NextString()
+		if got, want := Simple.NaturalCompare(s1, s2), NaturalCompare(s1, s2); got != want {
+			t.Errorf("Compared %#q to %#q: expected %v, got %v", s1, s2, want, got)
+		}
+		if got, want := Full.NaturalCompare(s1, s2), NaturalCompare(s1, s2); got != want {
+			t.Errorf("Compared %#q to %#q with full folding: expected %v, got %v", s1, s2, want, got)
+		}
+	}
+}
+
+func TestWidthFolding(t *testing.T) {
+	testset := []struct {
+		f      Folding
+		s1, s2 string
+		cmp    int
+	}{
+		{Width, "ＦＩＬＥ2", "file10", -1},
+		{Width, "ＦＩＬＥ2", "file1", +1},
+		{Width, "ＦＩＬＥ２", "file10", -1},
+		{Width, "ｆｉｌｅ１０", "FILE2", +1},
+		{Width, "ｆｉｌｅ１０", "file10", 0},
+		{Width, "ｆｉｌｅ０１０", "file10", +1},
+		{Width, "ｆｉｌｅ０１０", "file010", 0},
+		{Width, "a　b", "a b", 0},
+		{Width, "ｶﾀｶﾅ", "カタカナ", 0},
+		{Width, "ｶﾞｲﾄﾞ", "ガイド", 0},
+		{Width, "ﾊﾟﾝ", "パン", 0},
+		{Width, "ﾊﾟﾝ", "バン", +1},
+		{Width, "ｱﾞ", "ア\u3099", 0},
+		{Width, "ｶﾞ", "カ", +1},
+		{Width, "ｶﾞ", "キ", -1},
+		{Width, "₩", "￦", 0},
+		{Width | Turkish, "ＩＳＴＡＮＢＵＬ", "ıstanbul", 0},
+		{Width | Turkish, "ｉｓｔａｎｂｕｌ", "İstanbul", 0},
+		{Width | Full, "ＳＴＲＡＳＳＥ", "straße", 0},
+		{Width | LowerFirst, "ｆｉｌｅ", "file", +1},
+		{Width | LowerFirst, "ｆｉｌｅ2", "ＦＩＬＥ2", -1},
+		// Without Width, fullwidth forms sort after ASCII.
+		{Simple, "ＦＩＬＥ2", "file10", +1},
+		{Simple, "ｆｉｌｅ１０", "file２", +1},
+	}
+	for _, v := range testset {
+		if got := v.f.NaturalCompare(v.s1, v.s2); got != v.cmp {
+			t.Errorf("Folding %v: compared %#q to %#q: expected %v, got %v", v.f, v.s1, v.s2, v.cmp, got)
+		}
+	}
+	want := []string{"file1", "ＦＩＬＥ2", "file３", "ｆｉｌｅ１０", "FILE11", "file100"}
+	got := []string{"FILE11", "file100", "ｆｉｌｅ１０", "file３", "ＦＩＬＥ2", "file1"}
+	sort.Slice(got, func(i, j int) bool { return Width.NaturalLess(got[i], got[j]) })
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Error: sort failed, expected: %#q, got: %#q", want, got)
+	}
+}
+
+func TestFoldingKey(t *testing.T) {
+	gen := testgen.New(
+		"0", "0", "1", "2", "9", "a", "s", "S", "t", "ß", "ẞ", "ss", "ﬀ", "f", "F",
+		"ﬅ", "ᾳ", "α", "ι", "k", "K", "i", "I", "ı", "İ", "ﬁ", "\u0307", "\xff",
+		"０", "２", "ｉ", "Ｓ", "ｶ", "ﾞ", "ガ", "\u3000", " ",
+	)
+	for _, f := range []Folding{Simple, Full, Turkish, Full | Turkish, LowerFirst, Full | UpperFirst, Width, Width | Turkish | LowerFirst} {
+		for i := 0; i < 10000; i++ {
+			s1, s2 := gen.Next(), gen.Next()
+			k1, k2 := f.Key(s1), f.Key(s2)
+			if got, want := bytes.Compare(k1, k2), f.NaturalCompare(s1, s2); got != want {
+				t.Errorf("Folding %v: compared keys of %+q and %+q: expected %v, got %v (keys %x and %x)",
+					f, s1, s2, want, got, k1, k2)
+			}
+			if got, want := f.NaturalCompare(s1, s2), -f.NaturalCompare(s2, s1); got != want {
+				t.Errorf("Folding %v: compared %+q to %+q: got %v, but reverse comparison gives %v",
+					f, s1, s2, got, -want)
+			}
+		}
+	}
+}
+
+// Compare using fully case-folded NaturalCompare()
+func BenchmarkFullNaturalCompare(b *testing.B) {
+	set := testSet(300)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range set[0] {
+			k := (j + 1) % len(set[0])
+			_ = Full.NaturalCompare(set[0][j], set[0][k])
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package casefolded
+
+// KeyVersion is the version of the sort key format produced by AppendKey.
+// It is incremented like sortorder.KeyVersion whenever the format changes.
+//
+// Note that case folding is based on the Unicode tables of the Go version
+// used to build the program. Upgrading Go may change the keys of strings
+// containing characters that were added to Unicode since.
+const KeyVersion = 1
+
+// Key returns a case-folded sort key for s. See AppendKey for details.
+func Key(s string) []byte {
+	return AppendKey(nil, s)
+}
+
+// AppendKey appends a case-folded sort key for s to dst and returns the
+// extended buffer.
+//
+// Sort keys compare bytewise in case-folded natural order: for any strings
+// a and b, bytes.Compare(Key(a), Key(b)) == NaturalCompare(a, b). See
+// sortorder.AppendKey for what they are meant for.
+// Strings that only differ in case have identical keys.
+//
+// The key format (version 1, see KeyVersion) is that of sortorder.AppendKey,
+// applied to the UTF-8 encoding of the string after replacing every rune by
+// its lowest equivalent rune. Invalid UTF-8 is treated as U+FFFD.
+func AppendKey(dst []byte, s string) []byte {
+	return Simple.AppendKey(dst, s)
+}
+
+// keyLevel separates the parts of a sort key that break ties.
+// It is less than any other byte, so shorter strings sort first.
+const keyLevel = 0x00
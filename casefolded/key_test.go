@@ -0,0 +1,59 @@
+package casefolded
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fvbommel/sortorder/internal/testgen"
+)
+
+func TestKey(t *testing.T) {
+	testset := []struct {
+		s1, s2 string
+	}{
+		{"", "0"},
+		{"0", "00"},
+		{"AB12", "abc"},
+		{"ab2a", "AB10"},
+		{"a01b001", "A001B01"},
+		{"9a", "083A"},
+		{"a\x00", "a0"},
+		{"k", "K"},
+		{"Klm2", "Klm10"},
+		{"аб2аб", "АБ10аб"},
+		{"a\xff", "a�"},
+		{"a\xff", "a￾"},
+	}
+	for _, v := range testset {
+		checkKeys(t, v.s1, v.s2)
+		checkKeys(t, v.s2, v.s1)
+	}
+}
+
+func TestKeyRandom(t *testing.T) {
+	// Mix digits, letters of both cases and non-ASCII characters.
+	gen := testgen.New("0", "0", "1", "2", "9", "a", "A", "k", "K", "K", "ſ", "s", "б", "Б", "\x00", "\xff")
+	for i := 0; i < 10000; i++ {
+		checkKeys(t, gen.Next(), gen.Next())
+	}
+}
+
+func checkKeys(t *testing.T, s1, s2 string) {
+	t.Helper()
+	k1, k2 := Key(s1), Key(s2)
+	if got, want := bytes.Compare(k1, k2), NaturalCompare(s1, s2); got != want {
+		t.Errorf("Compared keys of %+q and %+q: expected %v, got %v (keys %x and %x)",
+			s1, s2, want, got, k1, k2)
+	}
+}
+
+func BenchmarkKey(b *testing.B) {
+	set := testSet(300)
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, s := range set[0] {
+			buf = AppendKey(buf[:0], s)
+		}
+	}
+}
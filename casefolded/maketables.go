@@ -0,0 +1,170 @@
+//go:build ignore
+
+// This program generates tables.go from the Unicode Character Database.
+// Run it with "go generate".
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The Unicode version should match that of the unicode package of the
+// oldest supported Go version (15.0.0 for Go 1.21), which the rest of the
+// folding is based on.
+var (
+	version = flag.String("version", "15.0.0", "Unicode version of the data files")
+	url     = flag.String("url", "", "URL or path of CaseFolding.txt (default: that of -version)")
+	dataURL = flag.String("data", "", "URL or path of UnicodeData.txt (default: that of -version)")
+	output  = flag.String("output", "tables.go", "output file")
+)
+
+func main() {
+	flag.Parse()
+	if *url == "" {
+		*url = "https://www.unicode.org/Public/" + *version + "/ucd/CaseFolding.txt"
+	}
+	if *dataURL == "" {
+		*dataURL = "https://www.unicode.org/Public/" + *version + "/ucd/UnicodeData.txt"
+	}
+
+	in, err := open(*url)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer in.Close()
+
+	// Lines look like "00DF; F; 0073 0073; # LATIN SMALL LETTER SHARP S".
+	var full []string
+	s := bufio.NewScanner(in)
+	for s.Scan() {
+		line := s.Text()
+		if strings.HasPrefix(line, "# CaseFolding-") {
+			if v := strings.TrimSuffix(strings.TrimPrefix(strings.Fields(line)[1], "CaseFolding-"), ".txt"); v != *version {
+				log.Fatalf("%s: Unicode %s, expected %s", *url, v, *version)
+			}
+		}
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Split(line, ";")
+		if len(fields) < 3 {
+			continue
+		}
+		code, status := strings.TrimSpace(fields[0]), strings.TrimSpace(fields[1])
+		if status != "F" {
+			continue
+		}
+		var mapping []rune
+		for _, f := range strings.Fields(fields[2]) {
+			r, err := strconv.ParseUint(f, 16, 32)
+			if err != nil {
+				log.Fatalf("bad mapping %q: %v", line, err)
+			}
+			mapping = append(mapping, rune(r))
+		}
+		full = append(full, fmt.Sprintf("case 0x%s:\nreturn %+q", code, string(mapping)))
+	}
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	width, marks := readWidths(*dataURL)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by maketables.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package casefolded\n\n")
+	fmt.Fprintf(&buf, "// fullFold returns the full case folding of r (status F in\n")
+	fmt.Fprintf(&buf, "// CaseFolding.txt, Unicode %s), or \"\" if it has none.\n", *version)
+	fmt.Fprintf(&buf, "func fullFold(r rune) string {\nswitch r {\n%s\n}\nreturn \"\"\n}\n\n", strings.Join(full, "\n"))
+	fmt.Fprintf(&buf, "// widthFold returns the normal form of a fullwidth or halfwidth rune r\n")
+	fmt.Fprintf(&buf, "// (its <wide> or <narrow> decomposition in UnicodeData.txt, Unicode %s),\n", *version)
+	fmt.Fprintf(&buf, "// or 0 if it has none.\n")
+	fmt.Fprintf(&buf, "func widthFold(r rune) rune {\nswitch r {\n%s\n}\nreturn 0\n}\n\n", strings.Join(width, "\n"))
+	fmt.Fprintf(&buf, "// soundMark returns the precomposed kana for the normal form of a\n")
+	fmt.Fprintf(&buf, "// halfwidth kana followed by the normal form of a (semi-)voiced sound\n")
+	fmt.Fprintf(&buf, "// mark, or 0 if there is none.\n")
+	fmt.Fprintf(&buf, "func soundMark(kana, mark rune) rune {\nswitch mark {\n")
+	for _, mark := range []string{"3099", "309A"} {
+		fmt.Fprintf(&buf, "case 0x%s:\nswitch kana {\n%s\n}\n", mark, strings.Join(marks[mark], "\n"))
+	}
+	fmt.Fprintf(&buf, "}\nreturn 0\n}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readWidths reads the width mappings and the compositions of kana with
+// sound marks, by sound mark, from UnicodeData.txt.
+func readWidths(name string) (width []string, marks map[string][]string) {
+	in, err := open(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer in.Close()
+
+	// Lines look like "FF21;FULLWIDTH LATIN CAPITAL LETTER A;Lu;0;L;<wide> 0041;;;;N;;;;FF41;",
+	// with the decomposition in the sixth field.
+	narrow := make(map[string]bool)
+	var composed [][3]string
+	s := bufio.NewScanner(in)
+	for s.Scan() {
+		fields := strings.Split(s.Text(), ";")
+		if len(fields) < 6 {
+			continue
+		}
+		code, decomp := fields[0], strings.Fields(fields[5])
+		switch {
+		case len(decomp) == 2 && (decomp[0] == "<wide>" || decomp[0] == "<narrow>"):
+			width = append(width, fmt.Sprintf("case 0x%s:\nreturn 0x%s", code, decomp[1]))
+			if decomp[0] == "<narrow>" {
+				narrow[decomp[1]] = true
+			}
+		case len(decomp) == 2 && (decomp[1] == "3099" || decomp[1] == "309A"):
+			composed = append(composed, [3]string{code, decomp[0], decomp[1]})
+		}
+	}
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+	// Only kana with a halfwidth form can be followed by a halfwidth mark.
+	sort.Slice(composed, func(i, j int) bool { return composed[i][1] < composed[j][1] })
+	marks = make(map[string][]string)
+	for _, c := range composed {
+		if narrow[c[1]] {
+			marks[c[2]] = append(marks[c[2]], fmt.Sprintf("case 0x%s:\nreturn 0x%s", c[1], c[0]))
+		}
+	}
+	return width, marks
+}
+
+// open opens a URL or a local file.
+func open(name string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(name, "http://") && !strings.HasPrefix(name, "https://") {
+		return os.Open(name)
+	}
+	resp, err := http.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s: %s", name, resp.Status)
+	}
+	return resp.Body, nil
+}
@@ -1,6 +1,7 @@
 package casefolded
 
 import (
+	"cmp"
 	"unicode"
 	"unicode/utf8"
 )
@@ -19,10 +20,13 @@ import (
 //
 // Limitations:
 //   - only ASCII digits (0-9) are considered.
+//     Use a collate.Collator with the IgnoreCase and UnicodeDigits options
+//     to support other digits.
 //   - comparisons are done on a rune-by-rune basis,
-//     so some special case equivalences like 'ß' == 'SS" are not supported.
+//     so some special case equivalences like 'ß' == 'SS' are not supported.
+//     Use Full.NaturalLess to support them.
 //   - Special cases like Turkish 'i' == 'İ' (and not regular dotless 'I')
-//     are not supported either.
+//     are not supported either. Use Turkish.NaturalLess for those.
 type Natural []string
 
 func (n Natural) Len() int           { return len(n) }
@@ -56,11 +60,26 @@ func caseFold(r rune) rune {
 //
 // Limitations:
 //   - only ASCII digits (0-9) are considered.
+//     Use a collate.Collator with the IgnoreCase and UnicodeDigits options
+//     to support other digits.
 //   - comparisons are done on a rune-by-rune basis,
-//     so some special case equivalences like 'ß' == 'SS" are not supported.
+//     so some special case equivalences like 'ß' == 'SS' are not supported.
+//     Use Full.NaturalLess to support them.
 //   - Special cases like Turkish 'i' == 'İ' (and not regular dotless 'I')
-//     are not supported either.
+//     are not supported either. Use Turkish.NaturalLess for those.
 func NaturalLess(str1, str2 string) bool {
+	return NaturalCompare(str1, str2) < 0
+}
+
+// NaturalCompare compares two strings using case-folded natural ordering,
+// like NaturalLess.
+// The result will be 0 if str1 and str2 are equivalent, -1 if str1 < str2,
+// and +1 if str1 > str2, which makes it suitable for use with functions like
+// slices.SortFunc and slices.BinarySearchFunc.
+//
+// NaturalCompare(str1, str2) < 0 if and only if NaturalLess(str1, str2).
+// Strings that only differ in case compare as equal.
+func NaturalCompare(str1, str2 string) int {
 	// ASCII fast path.
 	idx1, idx2 := 0, 0
 	for idx1 < len(str1) && idx2 < len(str2) {
@@ -74,7 +93,10 @@ func NaturalLess(str1, str2 string) bool {
 		dig1, dig2 := isDigit(c1), isDigit(c2)
 		switch {
 		case dig1 != dig2: // Digits before other characters.
-			return dig1 // True if LHS is a digit, false if the RHS is one.
+			if dig1 { // LHS is a digit, so it's less.
+				return -1
+			}
+			return +1
 		case !dig1: // && !dig2, because dig1 == dig2
 			// For ASCII it suffices to normalize letters to upper-case,
 			// because upper-cased ASCII compares lexicographically.
@@ -90,7 +112,7 @@ func NaturalLess(str1, str2 string) bool {
 				idx2++
 				continue
 			}
-			return c1 < c2
+			return cmp.Compare(c1, c2)
 		default: // Digits
 			// Eat zeros.
 			for ; idx1 < len(str1) && str1[idx1] == '0'; idx1++ {
@@ -106,24 +128,24 @@ func NaturalLess(str1, str2 string) bool {
 			// If lengths of numbers with non-zero prefix differ, the shorter
 			// one is less.
 			if len1, len2 := idx1-nonZero1, idx2-nonZero2; len1 != len2 {
-				return len1 < len2
+				return cmp.Compare(len1, len2)
 			}
 			// If they're equally long, string comparison is correct.
 			if nr1, nr2 := str1[nonZero1:idx1], str2[nonZero2:idx2]; nr1 != nr2 {
-				return nr1 < nr2
+				return cmp.Compare(nr1, nr2)
 			}
 			// Otherwise, the one with less zeros is less.
 			// Because everything up to the number is equal, comparing the index
 			// after the zeros is sufficient.
 			if nonZero1 != nonZero2 {
-				return nonZero1 < nonZero2
+				return cmp.Compare(nonZero1, nonZero2)
 			}
 		}
 		// They're identical so far, so continue comparing.
 	}
 	// So far they are identical. At least one is ended. If the other continues,
 	// it sorts last.
-	return len(str1) < len(str2)
+	return cmp.Compare(len(str1), len(str2))
 
 hasUnicode:
 	for idx1 < len(str1) && idx2 < len(str2) {
@@ -133,7 +155,10 @@ hasUnicode:
 		dig1, dig2 := isDigit(c1), isDigit(c2)
 		switch {
 		case dig1 != dig2: // Digits before other characters.
-			return dig1 // True if LHS is a digit, false if the RHS is one.
+			if dig1 { // LHS is a digit, so it's less.
+				return -1
+			}
+			return +1
 		case !dig1: // && !dig2, because dig1 == dig2
 			idx1 += delta1
 			idx2 += delta2
@@ -147,7 +172,7 @@ hasUnicode:
 				c1 = unicode.ToUpper(c1)
 				c2 = unicode.ToUpper(c2)
 				if c1 != c2 {
-					return c1 < c2
+					return cmp.Compare(c1, c2)
 				}
 				continue
 			}
@@ -157,9 +182,10 @@ hasUnicode:
 			if c1 == c2 {
 				continue
 			}
-			return c1 < c2
+			return cmp.Compare(c1, c2)
 		default: // Digits
 			// Eat zeros.
+			start1, start2 := idx1, idx2
 			for ; idx1 < len(str1) && str1[idx1] == '0'; idx1++ {
 			}
 			for ; idx2 < len(str2) && str2[idx2] == '0'; idx2++ {
@@ -173,22 +199,22 @@ hasUnicode:
 			// If lengths of numbers with non-zero prefix differ, the shorter
 			// one is less.
 			if len1, len2 := idx1-nonZero1, idx2-nonZero2; len1 != len2 {
-				return len1 < len2
+				return cmp.Compare(len1, len2)
 			}
 			// If they're equally long, string comparison is correct.
 			if nr1, nr2 := str1[nonZero1:idx1], str2[nonZero2:idx2]; nr1 != nr2 {
-				return nr1 < nr2
+				return cmp.Compare(nr1, nr2)
 			}
 			// Otherwise, the one with less zeros is less.
-			// Because everything up to the number is equal, comparing the index
-			// after the zeros is sufficient.
-			if nonZero1 != nonZero2 {
-				return nonZero1 < nonZero2
+			// Note that equivalent runes may have different encoded lengths,
+			// so the indices after the zeros can't be compared directly.
+			if zeros1, zeros2 := nonZero1-start1, nonZero2-start2; zeros1 != zeros2 {
+				return cmp.Compare(zeros1, zeros2)
 			}
 		}
 		// They're identical so far, so continue comparing.
 	}
 	// So far they are identical. At least one is ended. If the other continues,
 	// it sorts last.
-	return len(str1[idx1:]) < len(str2[idx2:])
+	return cmp.Compare(len(str1[idx1:]), len(str2[idx2:]))
 }
@@ -94,6 +94,11 @@ func TestNaturalLess(t *testing.T) {
 		// Kelvin sign followed by numeric comparison
 		{"\u212alm2", "Klm10", true},
 		{"Klm01", "\u212alm2", true},
+		// Leading zeros after runes with different encoded lengths.
+		{"k0a10", "\u212a0", false},
+		{"\u212a0", "k0a10", true},
+		{"\u212a0", "k00", true},
+		{"k00", "\u212a0", false},
 	}
 	for _, v := range testset {
 		if got := NaturalLess(v.s1, v.s2); got != v.less {
@@ -113,6 +118,22 @@ func TestNaturalLess(t *testing.T) {
 	}
 }
 
+func TestNaturalCompare(t *testing.T) {
+	gen := &generator{src: rand.New(rand.NewSource(300))}
+	for i := 0; i < 10000; i++ {
+		s1, s2 := gen.NextString(), gen.NextString()
+		if got, want := NaturalCompare(s1, s2) < 0, NaturalLess(s1, s2); got != want {
+			t.Errorf("NaturalCompare(%#q, %#q) < 0 = %v, NaturalLess = %v", s1, s2, got, want)
+		}
+		if got, want := NaturalCompare(s1, s2), -NaturalCompare(s2, s1); got != want {
+			t.Errorf("NaturalCompare(%#q, %#q) = %v, reverse comparison gives %v", s1, s2, got, -want)
+		}
+		if got := NaturalCompare(s1, s1); got != 0 {
+			t.Errorf("NaturalCompare(%#q, %#q) = %v, expected 0", s1, s1, got)
+		}
+	}
+}
+
 // ToUpper, then use a regular string sort.
 // As this does not perform a natural sort,
 // this is not directly comparable with the other sorts.
@@ -249,6 +270,18 @@ func BenchmarkCaseFoldedNaturalLess(b *testing.B) {
 	}
 }
 
+// Compare using case-folded NaturalCompare()
+func BenchmarkCaseFoldedNaturalCompare(b *testing.B) {
+	set := testSet(300)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range set[0] {
+			k := (j + 1) % len(set[0])
+			_ = NaturalCompare(set[0][j], set[0][k])
+		}
+	}
+}
+
 // Get 1000 arrays of 10000-string-arrays (less if -short is specified).
 func testSet(seed int) [][]string {
 	gen := &generator{
@@ -0,0 +1,63 @@
+package casefolded
+
+import (
+	"unicode"
+
+	"github.com/fvbommel/sortorder/internal/rank"
+)
+
+// A ScriptOrder compares strings in case-folded natural order with the
+// folding rules of Folding, except that letters are ordered by their script
+// first, with Scripts (like unicode.Cyrillic) first, in the given order, and
+// all other scripts after them. For example, with
+// ScriptOrder{Scripts: []*unicode.RangeTable{unicode.Cyrillic, unicode.Latin}},
+// "борис 2" < "БОРИС 10" < "anna" < "Ωμέγα".
+//
+// Runes that are not part of a specific script, like white space,
+// punctuation and digits (those of unicode.Common), sort before all
+// scripts, unless their table is in Scripts too, while combining marks
+// (those of unicode.Inherited) sort like scripts that aren't. Numbers
+// still sort before all other runes and are compared naturally, and runes
+// are compared by their folded code point within a script.
+// Nil and repeated tables are skipped, and only the first 250 scripts are
+// used.
+//
+// Like Folding, a ScriptOrder is safe for concurrent use, as long as
+// Scripts isn't modified.
+type ScriptOrder struct {
+	Folding Folding
+	Scripts []*unicode.RangeTable
+}
+
+// NaturalLess compares two strings using case-folded natural ordering,
+// with letters ordered by script first.
+func (o ScriptOrder) NaturalLess(str1, str2 string) bool {
+	return o.NaturalCompare(str1, str2) < 0
+}
+
+// NaturalCompare compares two strings like o.NaturalLess.
+// The result will be 0 if str1 and str2 are equivalent, -1 if str1 < str2,
+// and +1 if str1 > str2.
+func (o ScriptOrder) NaturalCompare(str1, str2 string) int {
+	return o.Folding.compare(str1, str2, o.scripts())
+}
+
+// Key returns a sort key for s. See AppendKey for details.
+func (o ScriptOrder) Key(s string) []byte {
+	return o.AppendKey(nil, s)
+}
+
+// AppendKey appends a sort key for s to dst and returns the extended buffer.
+// For any strings a and b, bytes.Compare(o.Key(a), o.Key(b)) ==
+// o.NaturalCompare(a, b). The key format is that of o.Folding.AppendKey,
+// except that every folded rune is preceded by a byte holding the rank of
+// its script plus 2, so keys created with different script orders must not
+// be compared with each other.
+func (o ScriptOrder) AppendKey(dst []byte, s string) []byte {
+	return o.Folding.appendKey(dst, s, o.scripts())
+}
+
+// scripts returns the scripts that are used.
+func (o ScriptOrder) scripts() []*unicode.RangeTable {
+	return rank.Scripts(o.Scripts)
+}
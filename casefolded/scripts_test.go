@@ -0,0 +1,95 @@
+package casefolded
+
+import (
+	"bytes"
+	"cmp"
+	"testing"
+	"unicode"
+
+	"github.com/fvbommel/sortorder/internal/testgen"
+)
+
+func TestScriptOrder(t *testing.T) {
+	testset := []struct {
+		order ScriptOrder
+		want  []string
+	}{
+		{
+			ScriptOrder{Scripts: []*unicode.RangeTable{unicode.Cyrillic, unicode.Latin}},
+			[]string{"борис 2", "БОРИС 10", "Вера", "anna", "ZOE", "Ωμέγα", "北京"},
+		},
+		{
+			ScriptOrder{Full, []*unicode.RangeTable{unicode.Greek}},
+			[]string{"ΑΒ", "ωμέγα", "STRASSE 2", "Straße 10", "борис"},
+		},
+		{
+			ScriptOrder{Turkish, []*unicode.RangeTable{unicode.Cyrillic}},
+			[]string{"борис", "Isparta", "İstanbul 2", "istanbul 10", "İzmir"},
+		},
+		{
+			// White space and punctuation sort before all scripts, and
+			// numbers before that.
+			ScriptOrder{Scripts: []*unicode.RangeTable{unicode.Han}},
+			[]string{"第", "第2章", "第10章", "第 2", "第-2", "第章", "第a"},
+		},
+		{
+			ScriptOrder{LowerFirst, []*unicode.RangeTable{unicode.Greek, unicode.Latin}},
+			[]string{"α", "Α", "αa", "ab", "aB", "AB"},
+		},
+	}
+	for _, v := range testset {
+		for i := range v.want {
+			for j := range v.want {
+				if got, want := v.order.NaturalCompare(v.want[i], v.want[j]), cmp.Compare(i, j); got != want {
+					t.Errorf("Compared %#q to %#q: expected %v, got %v", v.want[i], v.want[j], want, got)
+				}
+			}
+		}
+	}
+	// Without scripts, a ScriptOrder is like its folding.
+	for _, v := range [][2]string{{"abc2", "ABC12"}, {"Straße", "STRASSE"}, {"б", "a"}} {
+		for _, f := range []Folding{Simple, Full, Full | UpperFirst} {
+			if got, want := (ScriptOrder{Folding: f}).NaturalCompare(v[0], v[1]), f.NaturalCompare(v[0], v[1]); got != want {
+				t.Errorf("Folding %v: compared %#q to %#q: expected %v, got %v", f, v[0], v[1], want, got)
+			}
+		}
+	}
+	// Nil and repeated tables are skipped.
+	o := ScriptOrder{Scripts: []*unicode.RangeTable{nil, unicode.Greek, nil, unicode.Greek, unicode.Cyrillic}}
+	clean := ScriptOrder{Scripts: []*unicode.RangeTable{unicode.Greek, unicode.Cyrillic}}
+	for _, s := range []string{"a", "α", "б", "北", "2", " "} {
+		if got, want := o.Key(s), clean.Key(s); !bytes.Equal(got, want) {
+			t.Errorf("Key(%#q) with nil and repeated tables: expected %x, got %x", s, want, got)
+		}
+	}
+	for _, v := range [][2]string{{"α", "б"}, {"б", "a"}, {"a", "北"}} {
+		if got := o.NaturalCompare(v[0], v[1]); got != -1 {
+			t.Errorf("Compared %#q to %#q with nil and repeated tables: expected -1, got %v", v[0], v[1], got)
+		}
+	}
+}
+
+func TestScriptOrderKey(t *testing.T) {
+	gen := testgen.New(
+		"0", "1", "2", "9", "a", "A", "s", "ß", "ss", "i", "İ", "ı", " ", "-",
+		"α", "Ω", "б", "Б", "北", "̇", "\xff",
+	)
+	for _, o := range []ScriptOrder{
+		{Simple, []*unicode.RangeTable{unicode.Cyrillic, unicode.Latin}},
+		{Full | Turkish, []*unicode.RangeTable{unicode.Greek}},
+		{UpperFirst, []*unicode.RangeTable{unicode.Han, unicode.Common}},
+	} {
+		for i := 0; i < 10000; i++ {
+			s1, s2 := gen.Next(), gen.Next()
+			k1, k2 := o.Key(s1), o.Key(s2)
+			if got, want := bytes.Compare(k1, k2), o.NaturalCompare(s1, s2); got != want {
+				t.Errorf("Folding %v: compared keys of %+q and %+q: expected %v, got %v (keys %x and %x)",
+					o.Folding, s1, s2, want, got, k1, k2)
+			}
+			if got, want := o.NaturalCompare(s1, s2), -o.NaturalCompare(s2, s1); got != want {
+				t.Errorf("Folding %v: compared %+q to %+q: got %v, but reverse comparison gives %v",
+					o.Folding, s1, s2, got, -want)
+			}
+		}
+	}
+}
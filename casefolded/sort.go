@@ -0,0 +1,45 @@
+package casefolded
+
+import "slices"
+
+// Sort sorts a slice of strings in case-folded natural order
+// (see NaturalLess). The elements may be of any string type,
+// so e.g. a []MyID can be sorted without converting it to a []string first.
+//
+// The sort is not guaranteed to be stable: strings that only differ in case
+// may be reordered. Use SortStable if that matters.
+func Sort[S ~[]E, E ~string](s S) {
+	slices.SortFunc(s, func(a, b E) int {
+		return NaturalCompare(string(a), string(b))
+	})
+}
+
+// SortStable sorts a slice of strings in case-folded natural order
+// (see NaturalLess), while keeping strings that only differ in case in their
+// original order.
+func SortStable[S ~[]E, E ~string](s S) {
+	slices.SortStableFunc(s, func(a, b E) int {
+		return NaturalCompare(string(a), string(b))
+	})
+}
+
+// SortByKey sorts a slice in the case-folded natural order of the keys
+// returned by the key function (see NaturalLess). The key function is called
+// for both elements of every comparison, so it should be cheap.
+//
+// The sort is not guaranteed to be stable: elements with equivalent keys may
+// be reordered. Use SortStableByKey if that matters.
+func SortByKey[S ~[]E, E any](s S, key func(E) string) {
+	slices.SortFunc(s, func(a, b E) int {
+		return NaturalCompare(key(a), key(b))
+	})
+}
+
+// SortStableByKey sorts a slice in the case-folded natural order of the keys
+// returned by the key function (see NaturalLess), while keeping elements with
+// equivalent keys in their original order.
+func SortStableByKey[S ~[]E, E any](s S, key func(E) string) {
+	slices.SortStableFunc(s, func(a, b E) int {
+		return NaturalCompare(key(a), key(b))
+	})
+}
@@ -0,0 +1,58 @@
+package casefolded
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testID string
+
+func TestSort(t *testing.T) {
+	want := []testID{"ab", "ABC1", "abc01", "abc2", "aBc5", "Abc10"}
+	got := []testID{"aBc5", "ABC1", "abc01", "ab", "Abc10", "abc2"}
+	Sort(got)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Error: sort failed, expected: %#q, got: %#q", want, got)
+	}
+}
+
+func TestSortStable(t *testing.T) {
+	want := []testID{"abc", "ABC", "Abc", "abc2", "ABC2", "abc10"}
+	got := []testID{"abc10", "abc", "abc2", "ABC", "ABC2", "Abc"}
+	SortStable(got)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Error: stable sort failed, expected: %#q, got: %#q", want, got)
+	}
+}
+
+func TestSortByKey(t *testing.T) {
+	type file struct {
+		name string
+		idx  int
+	}
+	files := []file{
+		{"Report10", 0}, {"report2", 1}, {"REPORT2", 2},
+		{"notes", 3}, {"Report2", 4},
+	}
+	name := func(f file) string { return f.name }
+
+	want := []file{
+		{"notes", 3},
+		{"report2", 1}, {"REPORT2", 2}, {"Report2", 4},
+		{"Report10", 0},
+	}
+	got := append([]file(nil), files...)
+	SortStableByKey(got, name)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Error: stable sort failed, expected: %v, got: %v", want, got)
+	}
+
+	got = append([]file(nil), files...)
+	SortByKey(got, name)
+	for i := range got {
+		if NaturalCompare(got[i].name, want[i].name) != 0 {
+			t.Errorf("Error: sort failed, expected: %v, got: %v", want, got)
+			break
+		}
+	}
+}
@@ -0,0 +1,751 @@
+// Code generated by maketables.go; DO NOT EDIT.
+
+package casefolded
+
+// fullFold returns the full case folding of r (status F in
+// CaseFolding.txt, Unicode 15.0.0), or "" if it has none.
+func fullFold(r rune) string {
+	switch r {
+	case 0x00DF:
+		return "ss"
+	case 0x0130:
+		return "i\u0307"
+	case 0x0149:
+		return "\u02bcn"
+	case 0x01F0:
+		return "j\u030c"
+	case 0x0390:
+		return "\u03b9\u0308\u0301"
+	case 0x03B0:
+		return "\u03c5\u0308\u0301"
+	case 0x0587:
+		return "\u0565\u0582"
+	case 0x1E96:
+		return "h\u0331"
+	case 0x1E97:
+		return "t\u0308"
+	case 0x1E98:
+		return "w\u030a"
+	case 0x1E99:
+		return "y\u030a"
+	case 0x1E9A:
+		return "a\u02be"
+	case 0x1E9E:
+		return "ss"
+	case 0x1F50:
+		return "\u03c5\u0313"
+	case 0x1F52:
+		return "\u03c5\u0313\u0300"
+	case 0x1F54:
+		return "\u03c5\u0313\u0301"
+	case 0x1F56:
+		return "\u03c5\u0313\u0342"
+	case 0x1F80:
+		return "\u1f00\u03b9"
+	case 0x1F81:
+		return "\u1f01\u03b9"
+	case 0x1F82:
+		return "\u1f02\u03b9"
+	case 0x1F83:
+		return "\u1f03\u03b9"
+	case 0x1F84:
+		return "\u1f04\u03b9"
+	case 0x1F85:
+		return "\u1f05\u03b9"
+	case 0x1F86:
+		return "\u1f06\u03b9"
+	case 0x1F87:
+		return "\u1f07\u03b9"
+	case 0x1F88:
+		return "\u1f00\u03b9"
+	case 0x1F89:
+		return "\u1f01\u03b9"
+	case 0x1F8A:
+		return "\u1f02\u03b9"
+	case 0x1F8B:
+		return "\u1f03\u03b9"
+	case 0x1F8C:
+		return "\u1f04\u03b9"
+	case 0x1F8D:
+		return "\u1f05\u03b9"
+	case 0x1F8E:
+		return "\u1f06\u03b9"
+	case 0x1F8F:
+		return "\u1f07\u03b9"
+	case 0x1F90:
+		return "\u1f20\u03b9"
+	case 0x1F91:
+		return "\u1f21\u03b9"
+	case 0x1F92:
+		return "\u1f22\u03b9"
+	case 0x1F93:
+		return "\u1f23\u03b9"
+	case 0x1F94:
+		return "\u1f24\u03b9"
+	case 0x1F95:
+		return "\u1f25\u03b9"
+	case 0x1F96:
+		return "\u1f26\u03b9"
+	case 0x1F97:
+		return "\u1f27\u03b9"
+	case 0x1F98:
+		return "\u1f20\u03b9"
+	case 0x1F99:
+		return "\u1f21\u03b9"
+	case 0x1F9A:
+		return "\u1f22\u03b9"
+	case 0x1F9B:
+		return "\u1f23\u03b9"
+	case 0x1F9C:
+		return "\u1f24\u03b9"
+	case 0x1F9D:
+		return "\u1f25\u03b9"
+	case 0x1F9E:
+		return "\u1f26\u03b9"
+	case 0x1F9F:
+		return "\u1f27\u03b9"
+	case 0x1FA0:
+		return "\u1f60\u03b9"
+	case 0x1FA1:
+		return "\u1f61\u03b9"
+	case 0x1FA2:
+		return "\u1f62\u03b9"
+	case 0x1FA3:
+		return "\u1f63\u03b9"
+	case 0x1FA4:
+		return "\u1f64\u03b9"
+	case 0x1FA5:
+		return "\u1f65\u03b9"
+	case 0x1FA6:
+		return "\u1f66\u03b9"
+	case 0x1FA7:
+		return "\u1f67\u03b9"
+	case 0x1FA8:
+		return "\u1f60\u03b9"
+	case 0x1FA9:
+		return "\u1f61\u03b9"
+	case 0x1FAA:
+		return "\u1f62\u03b9"
+	case 0x1FAB:
+		return "\u1f63\u03b9"
+	case 0x1FAC:
+		return "\u1f64\u03b9"
+	case 0x1FAD:
+		return "\u1f65\u03b9"
+	case 0x1FAE:
+		return "\u1f66\u03b9"
+	case 0x1FAF:
+		return "\u1f67\u03b9"
+	case 0x1FB2:
+		return "\u1f70\u03b9"
+	case 0x1FB3:
+		return "\u03b1\u03b9"
+	case 0x1FB4:
+		return "\u03ac\u03b9"
+	case 0x1FB6:
+		return "\u03b1\u0342"
+	case 0x1FB7:
+		return "\u03b1\u0342\u03b9"
+	case 0x1FBC:
+		return "\u03b1\u03b9"
+	case 0x1FC2:
+		return "\u1f74\u03b9"
+	case 0x1FC3:
+		return "\u03b7\u03b9"
+	case 0x1FC4:
+		return "\u03ae\u03b9"
+	case 0x1FC6:
+		return "\u03b7\u0342"
+	case 0x1FC7:
+		return "\u03b7\u0342\u03b9"
+	case 0x1FCC:
+		return "\u03b7\u03b9"
+	case 0x1FD2:
+		return "\u03b9\u0308\u0300"
+	case 0x1FD3:
+		return "\u03b9\u0308\u0301"
+	case 0x1FD6:
+		return "\u03b9\u0342"
+	case 0x1FD7:
+		return "\u03b9\u0308\u0342"
+	case 0x1FE2:
+		return "\u03c5\u0308\u0300"
+	case 0x1FE3:
+		return "\u03c5\u0308\u0301"
+	case 0x1FE4:
+		return "\u03c1\u0313"
+	case 0x1FE6:
+		return "\u03c5\u0342"
+	case 0x1FE7:
+		return "\u03c5\u0308\u0342"
+	case 0x1FF2:
+		return "\u1f7c\u03b9"
+	case 0x1FF3:
+		return "\u03c9\u03b9"
+	case 0x1FF4:
+		return "\u03ce\u03b9"
+	case 0x1FF6:
+		return "\u03c9\u0342"
+	case 0x1FF7:
+		return "\u03c9\u0342\u03b9"
+	case 0x1FFC:
+		return "\u03c9\u03b9"
+	case 0xFB00:
+		return "ff"
+	case 0xFB01:
+		return "fi"
+	case 0xFB02:
+		return "fl"
+	case 0xFB03:
+		return "ffi"
+	case 0xFB04:
+		return "ffl"
+	case 0xFB05:
+		return "st"
+	case 0xFB06:
+		return "st"
+	case 0xFB13:
+		return "\u0574\u0576"
+	case 0xFB14:
+		return "\u0574\u0565"
+	case 0xFB15:
+		return "\u0574\u056b"
+	case 0xFB16:
+		return "\u057e\u0576"
+	case 0xFB17:
+		return "\u0574\u056d"
+	}
+	return ""
+}
+
+// widthFold returns the normal form of a fullwidth or halfwidth rune r
+// (its <wide> or <narrow> decomposition in UnicodeData.txt, Unicode 15.0.0),
+// or 0 if it has none.
+func widthFold(r rune) rune {
+	switch r {
+	case 0x3000:
+		return 0x0020
+	case 0xFF01:
+		return 0x0021
+	case 0xFF02:
+		return 0x0022
+	case 0xFF03:
+		return 0x0023
+	case 0xFF04:
+		return 0x0024
+	case 0xFF05:
+		return 0x0025
+	case 0xFF06:
+		return 0x0026
+	case 0xFF07:
+		return 0x0027
+	case 0xFF08:
+		return 0x0028
+	case 0xFF09:
+		return 0x0029
+	case 0xFF0A:
+		return 0x002A
+	case 0xFF0B:
+		return 0x002B
+	case 0xFF0C:
+		return 0x002C
+	case 0xFF0D:
+		return 0x002D
+	case 0xFF0E:
+		return 0x002E
+	case 0xFF0F:
+		return 0x002F
+	case 0xFF10:
+		return 0x0030
+	case 0xFF11:
+		return 0x0031
+	case 0xFF12:
+		return 0x0032
+	case 0xFF13:
+		return 0x0033
+	case 0xFF14:
+		return 0x0034
+	case 0xFF15:
+		return 0x0035
+	case 0xFF16:
+		return 0x0036
+	case 0xFF17:
+		return 0x0037
+	case 0xFF18:
+		return 0x0038
+	case 0xFF19:
+		return 0x0039
+	case 0xFF1A:
+		return 0x003A
+	case 0xFF1B:
+		return 0x003B
+	case 0xFF1C:
+		return 0x003C
+	case 0xFF1D:
+		return 0x003D
+	case 0xFF1E:
+		return 0x003E
+	case 0xFF1F:
+		return 0x003F
+	case 0xFF20:
+		return 0x0040
+	case 0xFF21:
+		return 0x0041
+	case 0xFF22:
+		return 0x0042
+	case 0xFF23:
+		return 0x0043
+	case 0xFF24:
+		return 0x0044
+	case 0xFF25:
+		return 0x0045
+	case 0xFF26:
+		return 0x0046
+	case 0xFF27:
+		return 0x0047
+	case 0xFF28:
+		return 0x0048
+	case 0xFF29:
+		return 0x0049
+	case 0xFF2A:
+		return 0x004A
+	case 0xFF2B:
+		return 0x004B
+	case 0xFF2C:
+		return 0x004C
+	case 0xFF2D:
+		return 0x004D
+	case 0xFF2E:
+		return 0x004E
+	case 0xFF2F:
+		return 0x004F
+	case 0xFF30:
+		return 0x0050
+	case 0xFF31:
+		return 0x0051
+	case 0xFF32:
+		return 0x0052
+	case 0xFF33:
+		return 0x0053
+	case 0xFF34:
+		return 0x0054
+	case 0xFF35:
+		return 0x0055
+	case 0xFF36:
+		return 0x0056
+	case 0xFF37:
+		return 0x0057
+	case 0xFF38:
+		return 0x0058
+	case 0xFF39:
+		return 0x0059
+	case 0xFF3A:
+		return 0x005A
+	case 0xFF3B:
+		return 0x005B
+	case 0xFF3C:
+		return 0x005C
+	case 0xFF3D:
+		return 0x005D
+	case 0xFF3E:
+		return 0x005E
+	case 0xFF3F:
+		return 0x005F
+	case 0xFF40:
+		return 0x0060
+	case 0xFF41:
+		return 0x0061
+	case 0xFF42:
+		return 0x0062
+	case 0xFF43:
+		return 0x0063
+	case 0xFF44:
+		return 0x0064
+	case 0xFF45:
+		return 0x0065
+	case 0xFF46:
+		return 0x0066
+	case 0xFF47:
+		return 0x0067
+	case 0xFF48:
+		return 0x0068
+	case 0xFF49:
+		return 0x0069
+	case 0xFF4A:
+		return 0x006A
+	case 0xFF4B:
+		return 0x006B
+	case 0xFF4C:
+		return 0x006C
+	case 0xFF4D:
+		return 0x006D
+	case 0xFF4E:
+		return 0x006E
+	case 0xFF4F:
+		return 0x006F
+	case 0xFF50:
+		return 0x0070
+	case 0xFF51:
+		return 0x0071
+	case 0xFF52:
+		return 0x0072
+	case 0xFF53:
+		return 0x0073
+	case 0xFF54:
+		return 0x0074
+	case 0xFF55:
+		return 0x0075
+	case 0xFF56:
+		return 0x0076
+	case 0xFF57:
+		return 0x0077
+	case 0xFF58:
+		return 0x0078
+	case 0xFF59:
+		return 0x0079
+	case 0xFF5A:
+		return 0x007A
+	case 0xFF5B:
+		return 0x007B
+	case 0xFF5C:
+		return 0x007C
+	case 0xFF5D:
+		return 0x007D
+	case 0xFF5E:
+		return 0x007E
+	case 0xFF5F:
+		return 0x2985
+	case 0xFF60:
+		return 0x2986
+	case 0xFF61:
+		return 0x3002
+	case 0xFF62:
+		return 0x300C
+	case 0xFF63:
+		return 0x300D
+	case 0xFF64:
+		return 0x3001
+	case 0xFF65:
+		return 0x30FB
+	case 0xFF66:
+		return 0x30F2
+	case 0xFF67:
+		return 0x30A1
+	case 0xFF68:
+		return 0x30A3
+	case 0xFF69:
+		return 0x30A5
+	case 0xFF6A:
+		return 0x30A7
+	case 0xFF6B:
+		return 0x30A9
+	case 0xFF6C:
+		return 0x30E3
+	case 0xFF6D:
+		return 0x30E5
+	case 0xFF6E:
+		return 0x30E7
+	case 0xFF6F:
+		return 0x30C3
+	case 0xFF70:
+		return 0x30FC
+	case 0xFF71:
+		return 0x30A2
+	case 0xFF72:
+		return 0x30A4
+	case 0xFF73:
+		return 0x30A6
+	case 0xFF74:
+		return 0x30A8
+	case 0xFF75:
+		return 0x30AA
+	case 0xFF76:
+		return 0x30AB
+	case 0xFF77:
+		return 0x30AD
+	case 0xFF78:
+		return 0x30AF
+	case 0xFF79:
+		return 0x30B1
+	case 0xFF7A:
+		return 0x30B3
+	case 0xFF7B:
+		return 0x30B5
+	case 0xFF7C:
+		return 0x30B7
+	case 0xFF7D:
+		return 0x30B9
+	case 0xFF7E:
+		return 0x30BB
+	case 0xFF7F:
+		return 0x30BD
+	case 0xFF80:
+		return 0x30BF
+	case 0xFF81:
+		return 0x30C1
+	case 0xFF82:
+		return 0x30C4
+	case 0xFF83:
+		return 0x30C6
+	case 0xFF84:
+		return 0x30C8
+	case 0xFF85:
+		return 0x30CA
+	case 0xFF86:
+		return 0x30CB
+	case 0xFF87:
+		return 0x30CC
+	case 0xFF88:
+		return 0x30CD
+	case 0xFF89:
+		return 0x30CE
+	case 0xFF8A:
+		return 0x30CF
+	case 0xFF8B:
+		return 0x30D2
+	case 0xFF8C:
+		return 0x30D5
+	case 0xFF8D:
+		return 0x30D8
+	case 0xFF8E:
+		return 0x30DB
+	case 0xFF8F:
+		return 0x30DE
+	case 0xFF90:
+		return 0x30DF
+	case 0xFF91:
+		return 0x30E0
+	case 0xFF92:
+		return 0x30E1
+	case 0xFF93:
+		return 0x30E2
+	case 0xFF94:
+		return 0x30E4
+	case 0xFF95:
+		return 0x30E6
+	case 0xFF96:
+		return 0x30E8
+	case 0xFF97:
+		return 0x30E9
+	case 0xFF98:
+		return 0x30EA
+	case 0xFF99:
+		return 0x30EB
+	case 0xFF9A:
+		return 0x30EC
+	case 0xFF9B:
+		return 0x30ED
+	case 0xFF9C:
+		return 0x30EF
+	case 0xFF9D:
+		return 0x30F3
+	case 0xFF9E:
+		return 0x3099
+	case 0xFF9F:
+		return 0x309A
+	case 0xFFA0:
+		return 0x3164
+	case 0xFFA1:
+		return 0x3131
+	case 0xFFA2:
+		return 0x3132
+	case 0xFFA3:
+		return 0x3133
+	case 0xFFA4:
+		return 0x3134
+	case 0xFFA5:
+		return 0x3135
+	case 0xFFA6:
+		return 0x3136
+	case 0xFFA7:
+		return 0x3137
+	case 0xFFA8:
+		return 0x3138
+	case 0xFFA9:
+		return 0x3139
+	case 0xFFAA:
+		return 0x313A
+	case 0xFFAB:
+		return 0x313B
+	case 0xFFAC:
+		return 0x313C
+	case 0xFFAD:
+		return 0x313D
+	case 0xFFAE:
+		return 0x313E
+	case 0xFFAF:
+		return 0x313F
+	case 0xFFB0:
+		return 0x3140
+	case 0xFFB1:
+		return 0x3141
+	case 0xFFB2:
+		return 0x3142
+	case 0xFFB3:
+		return 0x3143
+	case 0xFFB4:
+		return 0x3144
+	case 0xFFB5:
+		return 0x3145
+	case 0xFFB6:
+		return 0x3146
+	case 0xFFB7:
+		return 0x3147
+	case 0xFFB8:
+		return 0x3148
+	case 0xFFB9:
+		return 0x3149
+	case 0xFFBA:
+		return 0x314A
+	case 0xFFBB:
+		return 0x314B
+	case 0xFFBC:
+		return 0x314C
+	case 0xFFBD:
+		return 0x314D
+	case 0xFFBE:
+		return 0x314E
+	case 0xFFC2:
+		return 0x314F
+	case 0xFFC3:
+		return 0x3150
+	case 0xFFC4:
+		return 0x3151
+	case 0xFFC5:
+		return 0x3152
+	case 0xFFC6:
+		return 0x3153
+	case 0xFFC7:
+		return 0x3154
+	case 0xFFCA:
+		return 0x3155
+	case 0xFFCB:
+		return 0x3156
+	case 0xFFCC:
+		return 0x3157
+	case 0xFFCD:
+		return 0x3158
+	case 0xFFCE:
+		return 0x3159
+	case 0xFFCF:
+		return 0x315A
+	case 0xFFD2:
+		return 0x315B
+	case 0xFFD3:
+		return 0x315C
+	case 0xFFD4:
+		return 0x315D
+	case 0xFFD5:
+		return 0x315E
+	case 0xFFD6:
+		return 0x315F
+	case 0xFFD7:
+		return 0x3160
+	case 0xFFDA:
+		return 0x3161
+	case 0xFFDB:
+		return 0x3162
+	case 0xFFDC:
+		return 0x3163
+	case 0xFFE0:
+		return 0x00A2
+	case 0xFFE1:
+		return 0x00A3
+	case 0xFFE2:
+		return 0x00AC
+	case 0xFFE3:
+		return 0x00AF
+	case 0xFFE4:
+		return 0x00A6
+	case 0xFFE5:
+		return 0x00A5
+	case 0xFFE6:
+		return 0x20A9
+	case 0xFFE8:
+		return 0x2502
+	case 0xFFE9:
+		return 0x2190
+	case 0xFFEA:
+		return 0x2191
+	case 0xFFEB:
+		return 0x2192
+	case 0xFFEC:
+		return 0x2193
+	case 0xFFED:
+		return 0x25A0
+	case 0xFFEE:
+		return 0x25CB
+	}
+	return 0
+}
+
+// soundMark returns the precomposed kana for the normal form of a
+// halfwidth kana followed by the normal form of a (semi-)voiced sound
+// mark, or 0 if there is none.
+func soundMark(kana, mark rune) rune {
+	switch mark {
+	case 0x3099:
+		switch kana {
+		case 0x30A6:
+			return 0x30F4
+		case 0x30AB:
+			return 0x30AC
+		case 0x30AD:
+			return 0x30AE
+		case 0x30AF:
+			return 0x30B0
+		case 0x30B1:
+			return 0x30B2
+		case 0x30B3:
+			return 0x30B4
+		case 0x30B5:
+			return 0x30B6
+		case 0x30B7:
+			return 0x30B8
+		case 0x30B9:
+			return 0x30BA
+		case 0x30BB:
+			return 0x30BC
+		case 0x30BD:
+			return 0x30BE
+		case 0x30BF:
+			return 0x30C0
+		case 0x30C1:
+			return 0x30C2
+		case 0x30C4:
+			return 0x30C5
+		case 0x30C6:
+			return 0x30C7
+		case 0x30C8:
+			return 0x30C9
+		case 0x30CF:
+			return 0x30D0
+		case 0x30D2:
+			return 0x30D3
+		case 0x30D5:
+			return 0x30D6
+		case 0x30D8:
+			return 0x30D9
+		case 0x30DB:
+			return 0x30DC
+		case 0x30EF:
+			return 0x30F7
+		case 0x30F2:
+			return 0x30FA
+		}
+	case 0x309A:
+		switch kana {
+		case 0x30CF:
+			return 0x30D1
+		case 0x30D2:
+			return 0x30D4
+		case 0x30D5:
+			return 0x30D7
+		case 0x30D8:
+			return 0x30DA
+		case 0x30DB:
+			return 0x30DD
+		}
+	}
+	return 0
+}
@@ -0,0 +1,229 @@
+// Package chinese implements natural sort orders for Chinese text, which
+// order Han characters by their pinyin or by their strokes, as the Chinese
+// collations of the Unicode Common Locale Data Repository (CLDR) do.
+//
+// This is a separate sub-package because it needs tables of more than
+// 20,000 characters, which can add significantly to the size of binaries.
+package chinese // import "github.com/fvbommel/sortorder/chinese"
+
+//go:generate go run maketables.go
+
+import (
+	"cmp"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// An Order is a sort order of Han characters.
+// It is safe for concurrent use.
+type Order uint8
+
+const (
+	// Pinyin orders Han characters by their pinyin, the romanization of
+	// their Mandarin pronunciation, and sorts them after the Latin letter
+	// their pinyin starts with, so they're interleaved with Latin text.
+	// For example, "Berlin" < "北京" < "Cairo" < "广州" < "上海" < "深圳".
+	// As only the first letter of the pinyin is known, Han characters sort
+	// after all Latin text starting with the same letter, like "Bz" < "北".
+	// Characters with several readings sort by their most common one.
+	Pinyin Order = iota
+
+	// Stroke orders Han characters by their number of strokes, then by the
+	// order of their strokes. They sort together, like '一', the first of
+	// them, so they sort after Latin text.
+	// For example, "Cairo" < "一" < "人" < "大" < "中" < "北京".
+	Stroke
+)
+
+// Natural implements sort.Interface to sort strings in natural pinyin order.
+// This means that e.g. "第2章" < "第10章" and "北京" < "上海".
+// See NaturalLess for details.
+type Natural []string
+
+func (n Natural) Len() int           { return len(n) }
+func (n Natural) Swap(i, j int)      { n[i], n[j] = n[j], n[i] }
+func (n Natural) Less(i, j int) bool { return NaturalLess(n[i], n[j]) }
+
+// NaturalLess compares two strings using natural pinyin ordering. This means
+// that e.g. "第2章" < "第10章" and "北京" < "上海". It's the same as
+// Pinyin.NaturalLess.
+func NaturalLess(str1, str2 string) bool {
+	return Pinyin.NaturalLess(str1, str2)
+}
+
+// NaturalCompare compares two strings like NaturalLess.
+// The result will be 0 if str1 and str2 are equivalent, -1 if str1 < str2,
+// and +1 if str1 > str2.
+func NaturalCompare(str1, str2 string) int {
+	return Pinyin.NaturalCompare(str1, str2)
+}
+
+// NaturalLess compares two strings using natural ordering, with Han
+// characters in the order of o.
+//
+// Non-digit sequences and numbers are compared separately.
+// The former are compared rune-by-rune, with Han characters in the order
+// of o and other runes by their lowest case-equivalent rune, so Latin text
+// is compared case-insensitively. Han characters that aren't in the tables
+// of o sort after all that are, by code point, like the implicit weights of
+// the Unicode Collation Algorithm.
+// Numbers are sequences of ASCII digits and are compared numerically
+// (except that the number of leading zeros is used as a tie-breaker, so
+// e.g. "2" < "02"). They sort before all other characters.
+//
+// Invalid UTF-8 is treated as U+FFFD.
+func (o Order) NaturalLess(str1, str2 string) bool {
+	return o.NaturalCompare(str1, str2) < 0
+}
+
+// NaturalCompare compares two strings like o.NaturalLess.
+// The result will be 0 if str1 and str2 are equivalent, -1 if str1 < str2,
+// and +1 if str1 > str2.
+func (o Order) NaturalCompare(str1, str2 string) int {
+	idx1, idx2 := 0, 0
+	for idx1 < len(str1) && idx2 < len(str2) {
+		dig1, dig2 := isDigit(str1[idx1]), isDigit(str2[idx2])
+		switch {
+		case dig1 != dig2: // Digits before other characters.
+			if dig1 { // LHS is a digit, so it's less.
+				return -1
+			}
+			return +1
+		case !dig1: // && !dig2, because dig1 == dig2
+			c1, delta1 := utf8.DecodeRuneInString(str1[idx1:])
+			c2, delta2 := utf8.DecodeRuneInString(str2[idx2:])
+			idx1 += delta1
+			idx2 += delta2
+			// Fast path: identical runes are equal.
+			if c1 == c2 {
+				continue
+			}
+			if w1, w2 := o.weight(c1), o.weight(c2); w1 != w2 {
+				return cmp.Compare(w1, w2)
+			}
+		default: // Digits
+			// Eat zeros.
+			start1, start2 := idx1, idx2
+			for ; idx1 < len(str1) && str1[idx1] == '0'; idx1++ {
+			}
+			for ; idx2 < len(str2) && str2[idx2] == '0'; idx2++ {
+			}
+			// Eat all digits.
+			nonZero1, nonZero2 := idx1, idx2
+			for ; idx1 < len(str1) && isDigit(str1[idx1]); idx1++ {
+			}
+			for ; idx2 < len(str2) && isDigit(str2[idx2]); idx2++ {
+			}
+			// If lengths of numbers with non-zero prefix differ, the shorter
+			// one is less.
+			if len1, len2 := idx1-nonZero1, idx2-nonZero2; len1 != len2 {
+				return cmp.Compare(len1, len2)
+			}
+			// If they're equally long, string comparison is correct.
+			if nr1, nr2 := str1[nonZero1:idx1], str2[nonZero2:idx2]; nr1 != nr2 {
+				return cmp.Compare(nr1, nr2)
+			}
+			// Otherwise, the one with less zeros is less.
+			if zeros1, zeros2 := nonZero1-start1, nonZero2-start2; zeros1 != zeros2 {
+				return cmp.Compare(zeros1, zeros2)
+			}
+		}
+		// They're identical so far, so continue comparing.
+	}
+	// So far they are identical. At least one is ended. If the other continues,
+	// it sorts last.
+	return cmp.Compare(len(str1[idx1:]), len(str2[idx2:]))
+}
+
+// A weight orders a rune. Its upper bits hold the rune it sorts like, and
+// its lower 24 bits the rank of a Han character after that rune, or 0 for
+// other runes.
+type weight uint64
+
+// rankBits is the number of bits of the rank in a weight.
+const rankBits = 24
+
+// implicitRank is the lowest rank of Han characters that aren't in the
+// tables, which is greater than that of all characters that are. Their rank
+// is implicitRank plus their code point, after the last group of the order.
+const implicitRank = 1 << 16
+
+// weight returns the weight of r in o.
+func (o Order) weight(r rune) weight {
+	if r >= firstHan {
+		if w, ok := o.weights()[r]; ok {
+			return w
+		}
+		if unicode.Is(unicode.Han, r) {
+			return weight(o.lastGroup())<<rankBits | weight(implicitRank+r)
+		}
+	}
+	return weight(caseFold(r)) << rankBits
+}
+
+// group returns the rune a weight sorts like.
+func (w weight) group() rune { return rune(w >> rankBits) }
+
+// rank returns the rank of a weight after its group.
+func (w weight) rank() uint32 { return uint32(w & (1<<rankBits - 1)) }
+
+// firstHan is the lowest Han character in the tables.
+const firstHan = '⺀'
+
+// lastGroup returns the rune that the last Han characters of o sort like.
+func (o Order) lastGroup() rune {
+	if o == Stroke {
+		return '一'
+	}
+	return 'Z'
+}
+
+// weights returns the weights of the Han characters of o.
+func (o Order) weights() map[rune]weight {
+	if o == Stroke {
+		return strokeWeights()
+	}
+	return pinyinWeights()
+}
+
+// The weights are built on first use, so programs that don't use an order
+// don't pay for it.
+var (
+	pinyinWeights = sync.OnceValue(func() map[rune]weight {
+		weights := make(map[rune]weight)
+		rank := 0
+		for i, group := range pinyinGroups {
+			for _, r := range group {
+				rank++
+				weights[r] = weight('A'+i)<<rankBits | weight(rank)
+			}
+		}
+		return weights
+	})
+
+	strokeWeights = sync.OnceValue(func() map[rune]weight {
+		weights := make(map[rune]weight)
+		rank := 0
+		for _, group := range strokeGroups {
+			for _, r := range group {
+				rank++
+				weights[r] = weight('一')<<rankBits | weight(rank)
+			}
+		}
+		return weights
+	})
+)
+
+func isDigit(b byte) bool { return '0' <= b && b <= '9' }
+
+// caseFold returns the lowest-numbered rune equivalent to r.
+func caseFold(r rune) rune {
+	// Iterate until SimpleFold returns a lower value.
+	// This will be the lowest-numbered equivalent rune.
+	var prev rune = -1
+	for r > prev {
+		prev, r = r, unicode.SimpleFold(r)
+	}
+	return r
+}
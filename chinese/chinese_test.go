@@ -0,0 +1,94 @@
+package chinese
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestNaturalSort(t *testing.T) {
+	want := []string{
+		"Berlin", "北京", "北京 2", "北京 10", "cairo",
+		"第2章", "第10章", "第10章 上", "第010章",
+		"广州", "上海", "深圳", "天津",
+	}
+	got := make([]string, len(want))
+	for i := range got {
+		got[i] = want[len(got)-1-i]
+	}
+	sort.Sort(Natural(got))
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Error: sort failed, expected: %#q, got: %#q", want, got)
+	}
+}
+
+func TestNaturalLess(t *testing.T) {
+	testset := []struct {
+		o      Order
+		s1, s2 string
+		cmp    int
+	}{
+		{Pinyin, "第2章", "第10章", -1},
+		{Pinyin, "第10章", "第2章", +1},
+		{Pinyin, "第02章", "第2章", +1},
+		{Pinyin, "第2章", "第2章", 0},
+		{Pinyin, "北京", "Berlin", +1},
+		{Pinyin, "北京", "Bz", +1},
+		{Pinyin, "北京", "Cairo", -1},
+		{Pinyin, "北京", "上海", -1},
+		{Pinyin, "上海", "深圳", -1},
+		{Pinyin, "阿", "A", +1},
+		{Pinyin, "阿", "b", -1},
+		{Pinyin, "ABC", "abc", 0},
+		{Pinyin, "abc2", "ABC10", -1},
+		{Pinyin, "2", "a", -1},
+		{Pinyin, "2", "北", -1},
+		{Pinyin, "a\xff", "a�", 0},
+		{Stroke, "第2章", "第10章", -1},
+		{Stroke, "一", "人", -1},
+		{Stroke, "人", "大", -1},
+		{Stroke, "大", "中", -1},
+		{Stroke, "中", "北京", -1},
+		{Stroke, "北京", "上海", +1},
+		{Stroke, "Zurich", "一", -1},
+		{Stroke, "ABC", "abc", 0},
+		{Stroke, "2", "一", -1},
+		// Han characters that aren't in the tables, like U+3402 and
+		// U+20000, sort after all that are, by code point.
+		{Pinyin, "㐂", "Z", +1},
+		{Pinyin, "㐂", "座", +1},
+		{Pinyin, "㐂", "𠀀", -1},
+		{Pinyin, "㐂", "é", -1},
+		{Pinyin, "第㐂", "第2", +1},
+		{Stroke, "㐂", "一", +1},
+		{Stroke, "㐂", "龘", +1},
+		{Stroke, "㐂", "𠀀", -1},
+		{Stroke, "㐂", "㐀", +1},
+	}
+	for _, v := range testset {
+		if got := v.o.NaturalCompare(v.s1, v.s2); got != v.cmp {
+			t.Errorf("Order %v: compared %#q to %#q: expected %v, got %v", v.o, v.s1, v.s2, v.cmp, got)
+		}
+		if got := v.o.NaturalLess(v.s1, v.s2); got != (v.cmp < 0) {
+			t.Errorf("Order %v: Less(%#q, %#q): expected %v, got %v", v.o, v.s1, v.s2, v.cmp < 0, got)
+		}
+	}
+}
+
+func TestTables(t *testing.T) {
+	for _, o := range []Order{Pinyin, Stroke} {
+		weights := o.weights()
+		implicit := o.weight('㐂')
+		for r, w := range weights {
+			if r < firstHan {
+				t.Errorf("Order %v: %#q is less than firstHan", o, r)
+			}
+			if w >= implicit {
+				t.Errorf("Order %v: %#q doesn't sort before characters that aren't in the tables", o, r)
+			}
+		}
+		if len(weights) >= implicitRank {
+			t.Errorf("Order %v: %v ranks overlap the implicit ranks", o, len(weights))
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package chinese
+
+import (
+	"unicode/utf8"
+
+	"github.com/fvbommel/sortorder/internal/sortkey"
+)
+
+// KeyVersion is the version of the sort key format produced by AppendKey.
+// It is incremented like sortorder.KeyVersion whenever the format changes.
+//
+// Non-Han characters are case folded with the unicode package, so like
+// casefolded keys, the keys of strings containing characters that were
+// added to Unicode since may change when upgrading Go.
+const KeyVersion = 1
+
+// Key returns a pinyin sort key for s. See AppendKey for details.
+func Key(s string) []byte {
+	return AppendKey(nil, s)
+}
+
+// AppendKey appends a pinyin sort key for s to dst and returns the extended
+// buffer. It's the same as Pinyin.AppendKey.
+func AppendKey(dst []byte, s string) []byte {
+	return Pinyin.AppendKey(dst, s)
+}
+
+// Key returns a sort key for s. See AppendKey for details.
+func (o Order) Key(s string) []byte {
+	return o.AppendKey(nil, s)
+}
+
+// AppendKey appends a sort key for s to dst and returns the extended buffer.
+//
+// Sort keys compare bytewise in natural order: for any strings a and b,
+// bytes.Compare(o.Key(a), o.Key(b)) == o.NaturalCompare(a, b). See
+// sortorder.AppendKey for what they are meant for.
+//
+// The key format (version 1, see KeyVersion) is a concatenation of
+// encoded chunks, without any terminator:
+//   - a run of digits is encoded as 0x01, then the number of significant
+//     digits as a count, then the significant digits themselves and finally
+//     the number of leading zeros as a count, as in sortorder.AppendKey.
+//   - any other rune is encoded as the UTF-8 encoding of the rune it sorts
+//     like, with 2 added to every byte, followed by three bytes holding the
+//     big-endian rank of a Han character after that rune, or zero.
+//
+// Invalid UTF-8 is treated as U+FFFD. Keys created with different orders
+// must not be compared with each other.
+func (o Order) AppendKey(dst []byte, s string) []byte {
+	for idx := 0; idx < len(s); {
+		if !isDigit(s[idx]) {
+			r, size := utf8.DecodeRuneInString(s[idx:])
+			idx += size
+			w := o.weight(r)
+			start := len(dst)
+			dst = utf8.AppendRune(dst, w.group())
+			for i := start; i < len(dst); i++ {
+				dst[i] += 2
+			}
+			dst = append(dst, byte(w.rank()>>16), byte(w.rank()>>8), byte(w.rank()))
+			continue
+		}
+		// Eat zeros.
+		start := idx
+		for ; idx < len(s) && s[idx] == '0'; idx++ {
+		}
+		// Eat all digits.
+		nonZero := idx
+		for ; idx < len(s) && isDigit(s[idx]); idx++ {
+		}
+		dst = sortkey.AppendNumber(dst, s[nonZero:idx], nonZero-start)
+	}
+	return dst
+}
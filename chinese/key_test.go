@@ -0,0 +1,33 @@
+package chinese
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fvbommel/sortorder/internal/testgen"
+)
+
+func TestKeyRandom(t *testing.T) {
+	// Mix digits, letters of both cases, Han characters and invalid UTF-8.
+	gen := testgen.New(
+		"0", "0", "1", "2", "9", "a", "A", "b", "B", "z", "k", "K", "б",
+		"北", "京", "阿", "第", "章", "一", "中", "𠀀", "\x00", "\xff", "�",
+	)
+	for _, o := range []Order{Pinyin, Stroke} {
+		for i := 0; i < 10000; i++ {
+			s1, s2 := gen.Next(), gen.Next()
+			k1, k2 := o.Key(s1), o.Key(s2)
+			if got, want := bytes.Compare(k1, k2), o.NaturalCompare(s1, s2); got != want {
+				t.Errorf("Order %v: compared keys of %+q and %+q: expected %v, got %v (keys %x and %x)",
+					o, s1, s2, want, got, k1, k2)
+			}
+			if got, want := o.NaturalCompare(s1, s2), -o.NaturalCompare(s2, s1); got != want {
+				t.Errorf("Order %v: compared %+q to %+q: got %v, but reverse comparison gives %v",
+					o, s1, s2, got, -want)
+			}
+		}
+	}
+	if got, want := Key("第2章"), Pinyin.Key("第2章"); !bytes.Equal(got, want) {
+		t.Errorf("Key(%+q) = %x, expected %x", "第2章", got, want)
+	}
+}
@@ -0,0 +1,132 @@
+//go:build ignore
+
+// This program generates tables.go from the pinyin and stroke orders of the
+// Chinese collations in the Unicode Common Locale Data Repository (CLDR),
+// as listed by the Pinyin.pm and Stroke.pm modules of Perl's Unicode::Collate
+// distribution (in lib/Unicode/Collate/CJK). Run it with "go generate".
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var (
+	pinyin = flag.String("pinyin", "Pinyin.pm", "path of Pinyin.pm")
+	stroke = flag.String("stroke", "Stroke.pm", "path of Stroke.pm")
+	output = flag.String("output", "tables.go", "output file")
+)
+
+func main() {
+	flag.Parse()
+
+	pinyinGroups := readGroups(*pinyin)
+	strokeGroups := readGroups(*stroke)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by maketables.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package chinese\n\n")
+	fmt.Fprintf(&buf, "// pinyinGroups holds the characters in pinyin order, grouped by the first\n")
+	fmt.Fprintf(&buf, "// letter of their pinyin, from 'A' to 'Z'.\n")
+	fmt.Fprintf(&buf, "var pinyinGroups = [26]string{\n")
+	for letter := 'A'; letter <= 'Z'; letter++ {
+		writeGroup(&buf, fmt.Sprintf("%q", letter), pinyinGroups[0x0041+int(letter-'A')])
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+	fmt.Fprintf(&buf, "// strokeGroups holds the characters in stroke order, grouped by their\n")
+	fmt.Fprintf(&buf, "// number of strokes minus one.\n")
+	fmt.Fprintf(&buf, "var strokeGroups = [...]string{\n")
+	last := 0
+	for marker := range strokeGroups {
+		last = max(last, marker)
+	}
+	for marker := 0x2801; marker <= last; marker++ {
+		name := fmt.Sprintf("%d strokes", marker-0x2800)
+		if marker == 0x2801 {
+			name = "1 stroke"
+		}
+		writeGroup(&buf, name, strokeGroups[marker])
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readGroups reads the characters of a Perl module, by the marker of their
+// group. The data section lists hexadecimal code points in order, and each
+// group starts with a marker like "FDD0-0041", which is U+FDD0 followed by
+// the letter 'A' or the number of strokes plus 0x2800.
+func readGroups(name string) map[int][]rune {
+	in, err := os.Open(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer in.Close()
+
+	groups := make(map[int][]rune)
+	marker, data := -1, false
+	s := bufio.NewScanner(in)
+	for s.Scan() {
+		line := s.Text()
+		switch {
+		case line == "__DATA__":
+			data = true
+			continue
+		case line == "__END__":
+			data = false
+		}
+		if !data {
+			continue
+		}
+		for _, f := range strings.Fields(line) {
+			if m, ok := strings.CutPrefix(f, "FDD0-"); ok {
+				n, err := strconv.ParseUint(m, 16, 32)
+				if err != nil {
+					log.Fatalf("bad marker %q: %v", f, err)
+				}
+				marker = int(n)
+				continue
+			}
+			r, err := strconv.ParseUint(f, 16, 32)
+			if err != nil || marker < 0 {
+				log.Fatalf("bad code point %q: %v", f, err)
+			}
+			groups[marker] = append(groups[marker], rune(r))
+		}
+	}
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+	return groups
+}
+
+// writeGroup writes a group of characters as a string literal, with a
+// comment holding its name.
+func writeGroup(buf *bytes.Buffer, name string, group []rune) {
+	fmt.Fprintf(buf, "\t// %s\n", name)
+	if len(group) == 0 {
+		fmt.Fprintf(buf, "\t\"\",\n")
+		return
+	}
+	for i := 0; i < len(group); i += 40 {
+		line := string(group[i:min(i+40, len(group))])
+		if i+40 < len(group) {
+			fmt.Fprintf(buf, "\t\t%q +\n", line)
+		} else {
+			fmt.Fprintf(buf, "\t\t%q,\n", line)
+		}
+	}
+}
@@ -0,0 +1,1248 @@
+// Code generated by maketables.go; DO NOT EDIT.
+
+package chinese
+
+// pinyinGroups holds the characters in pinyin order, grouped by the first
+// letter of their pinyin, from 'A' to 'Z'.
+var pinyinGroups = [26]string{
+	// 'A'
+	"阿呵锕嗄啊哎哀唉埃娭挨欸溾嗳銰锿噯鎄啀捱皑溰嘊敱敳皚癌騃毐昹娾矮蔼躷濭藹霭靄艾伌" +
+		"爱砹硋隘嗌塧嫒愛碍叆暧瑷閡僾壒嬡懓薆鴱懝曖璦餲皧瞹馤礙譪譺鑀靉鱫安侒峖桉氨庵菴谙" +
+		"媕萻葊痷腤鹌蓭誝鞌鞍盦諳馣盫鵪韽鶕玵啽雸儑垵俺唵埯铵隌揞罯銨犴岸按洝荌案胺豻堓婩" +
+		"晻暗錌闇鮟黯肮骯卬岇昂昻枊盎醠凹柪梎軪爊敖厫隞嗷嗸嶅廒滶獓蔜遨摮熬獒璈磝翱聱螯謷" +
+		"謸翺鳌鏖鰲鷔鼇抝芺拗袄镺媪媼襖岙扷坳垇岰傲奡奥奧嫯慠骜隩墺嶴懊澳擙鏊驁翶",
+	// 'B'
+	"八仈扒朳玐夿岜芭峇柭疤哵巼捌粑羓蚆釛釟豝鲃叐犮抜坺妭拔茇炦癹胈菝詙跋軷颰魃墢鼥把" +
+		"钯鈀靶坝弝爸垻耙跁鲅鲌鮊覇矲霸壩灞欛巴叭吧笆紦罢魞罷挀掰擘白百佰柏栢捭瓸粨絔摆擺" +
+		"襬庍拝败拜敗猈稗蛽粺贁韛竡薭扳攽班般颁斑搬斒頒瘢鳻螌褩癍辬阪坂岅昄板版瓪钣粄舨鈑" +
+		"蝂魬闆办半伴坢姅怑拌绊柈秚湴絆鉡靽辦瓣扮螁邦垹帮捠梆浜邫幇幚縍幫鞤绑綁榜牓膀髈玤" +
+		"蚌傍棒棓谤塝搒稖蒡蜯磅镑艕謗鎊勹包孢苞枹胞笣煲龅蕔褒襃闁齙窇嫑雹薄宝怉饱保鸨宲珤" +
+		"堡堢媬葆寚飽褓駂鳵緥鴇賲寳寶靌勽报抱豹趵铇菢蚫袌報鉋鲍靤骲暴髱虣鮑儤曓爆忁鑤鸔佨" +
+		"藵陂卑杯盃桮悲揹椑禆碑鹎錃藣鵯北鉳贝孛狈貝邶备昁牬苝背郥钡俻倍悖狽被偝偹梖珼鄁備" +
+		"僃惫焙琲軰辈愂碚蓓犕褙誖鞁骳輩鋇憊糒鞴鐾呗唄禙奔泍贲栟犇锛錛本苯奙畚翉楍坋坌倴捹" +
+		"桳渀笨逩撪獖輽伻祊奟崩絣閍傰嵭痭嘣綳甭埄埲绷菶琣琫繃鞛泵迸逬塴甏镚蹦鏰蠯揼屄偪毴" +
+		"逼楅豍螕鵖鲾鎞鰏荸鼻匕比夶朼佊吡妣沘疕彼柀秕俾笔粃舭啚筆鄙箄聛貏币必毕闭佖坒庇诐" +
+		"邲妼怭怶枈畀苾哔柲毖珌疪荜陛毙狴畢笓粊袐铋婢庳敝梐萆閇閉堛弻弼愊愎湢皕筚詖貱賁赑" +
+		"嗶彃滗滭煏痹痺睤腷蓖蓽蜌裨跸鉍閟飶幣弊熚獙碧箅箆綼蔽鄪馝潷獘罼駜髲壁嬖廦篦篳縪薜" +
+		"觱避鮅斃濞臂蹕髀奰璧鄨鏎饆繴襞襣鞸韠魓躃躄驆贔鐴鷝鷩鼊匂萞幤襅嬶边辺砭笾揙猵编煸" +
+		"牑甂箯編蝙邉鍽鳊邊鞭鯾鯿籩贬扁窆匾貶惼萹碥稨褊糄鴘藊卞弁匥忭抃汳汴苄釆变玣便変昪" +
+		"覍徧缏遍閞辡緶艑辧辨辩辫辮辯變峅炞灬杓标飑骉髟淲彪猋脿颩墂幖摽滮蔈颮骠標熛膘瘭磦" +
+		"镖飙飚儦颷瀌藨謤爂臕贆鏢穮镳飆飇飈驃鑣驫表婊裱諘褾錶檦俵鳔鰾飊憋蟞鳖鱉鼈虌龞別别" +
+		"咇莂蛂徶襒蹩瘪癟彆汃邠玢砏宾彬梹傧斌椕滨缤槟瑸豩賓賔镔儐濒濱虨豳檳璸瀕霦繽鑌顮摈" +
+		"殡膑髩擯鬂殯臏髌鬓髕鬢氞濵冫仌仒氷冰兵掤丙邴陃怲抦秉苪昞昺柄炳饼眪窉蛃摒禀稟鈵鉼" +
+		"餅餠鞞并並併幷庰倂栤病竝偋傡寎棅誁鮩靐垪鞆鋲癶帗拨波癷玻剝剥哱盋砵袚钵饽紴缽菠袰" +
+		"碆鉢僠嶓撥播餑鮁蹳驋鱍仢伯犻肑驳帛狛瓝苩侼勃胉郣亳挬浡瓟秡袯钹铂脖舶袹博渤葧鹁愽" +
+		"搏猼鈸鉑馎僰煿牔箔艊蔔馛駁踣鋍镈馞駮襏豰嚗懪礡簙鎛餺鵓犦髆髉欂襮礴鑮跛箥簸孹檗糪" +
+		"譒蘗卜啵萡膊峬庯逋晡鈽誧鳪轐醭卟补哺捕喸補鵏不布佈吥步咘怖抪歨歩柨钚勏埔埗悑捗荹" +
+		"部钸埠瓿蔀踄郶餔篰餢簿",
+	// 'C'
+	"嚓擦攃礤遪囃偲婇猜才犲材财財裁溨纔毝采倸啋寀彩採睬跴綵踩埰菜棌蔡縩参參叄飡骖叅喰" +
+		"湌傪嬠餐驂残蚕惭殘慚蝅慙嬱蠶蠺惨朁慘憯穇篸黪黲灿掺孱粲摻澯薒燦璨謲儏爘仓仺伧沧苍" +
+		"鸧倉舱傖嵢滄獊蒼艙螥鶬藏鑶賶濸罉欌撡操糙曺曹嘈嶆漕蓸槽褿艚螬鏪艸草愺懆騲肏鄵襙艹" +
+		"冊册侧厕恻拺测敇畟側厠笧粣萗廁惻測策萴筞筴蓛墄箣憡簎嵾岑涔笒梣曽噌层曾層嶒竲驓蹭" +
+		"叉扠杈肞臿挿偛嗏插揷馇銟锸艖疀鍤餷秅垞查茬茶嵖搽猹靫槎詧察碴檫衩蹅镲鑔奼汊岔侘诧" +
+		"姹差紁詫芆拆钗釵侪柴豺祡喍儕齜茝虿袃訍瘥蠆囆辿觇梴搀覘裧鉆鋓幨襜攙婵谗棎湹禅馋煘" +
+		"缠僝獑蝉誗鋋儃嬋廛潹潺緾澶磛禪毚鄽镡瀍蟬儳劖蟾酁嚵巉瀺欃纏纒躔镵艬讒鑱饞产刬旵丳" +
+		"斺浐剗谄啴產産铲阐蒇剷嵼摌滻嘽幝蕆諂閳骣燀簅冁繟譂辴鏟闡囅灛讇忏硟摲懴颤懺羼韂顫" +
+		"壥伥昌倀娼淐猖菖阊晿琩裮锠錩閶鲳鯧鼚仧兏肠苌镸尝偿常徜瓺萇甞腸嘗塲嫦瑺膓鋿償嚐鲿" +
+		"鏛鱨厂场昶惝場僘厰廠氅鋹怅玚畅倡鬯唱悵焻瑒暢畼誯韔敞椙蟐抄弨怊欩钞訬焯超鈔勦牊晁" +
+		"巢巣朝鄛鼌漅嘲樔潮窲罺轈鼂謿吵炒眧焣煼麨巐仦仯耖觘车伡車俥砗唓莗硨蛼扯偖撦屮彻坼" +
+		"迠烢聅掣硩頙徹撤澈勶瞮爡抻郴捵琛嗔綝瞋諃賝縝謓尘臣忱沈沉辰陈迧茞宸莀莐陳敐訦谌軙" +
+		"愖揨鈂煁蔯塵樄瘎霃螴諶薼麎曟鷐趻硶碜墋夦磣踸鍖贂醦衬疢龀趁趂榇齓儬齔儭嚫谶櫬襯讖" +
+		"烥晨阷泟柽爯棦浾琤称偁蛏湞牚赪僜憆摚稱靗撐撑緽橕瞠赬頳檉竀穪蟶鏳鏿饓丞成朾呈承枨" +
+		"诚郕乗城娍宬峸洆荿乘埕挰晟珹脀掁珵碀窚脭铖堘惩棖椉程筬絾裎塍塖溗誠畻酲鋮憕澂澄橙" +
+		"檙瀓懲騬侱徎悜逞骋庱睈騁秤鯎吃侙哧彨胵蚩鸱瓻眵笞喫訵嗤媸摛痴絺噄瞝誺螭鴟癡魑齝彲" +
+		"黐弛池驰迟坻岻茌持竾荎歭蚳赿筂貾遅趍遟馳箎墀漦踟遲篪謘尺叺呎侈卶齿垑胣恥粎耻蚇袳" +
+		"欼歯袲裭鉹褫齒彳叱斥杘灻赤饬抶勅恜炽勑翄翅敕烾痓啻湁硳飭傺痸腟跮鉓雴憏瘈翤遫銐慗" +
+		"瘛翨熾懘趩饎鶒鷘妛麶充冲忡沖茺浺珫翀舂嘃摏徸憃憧衝罿艟蹖虫崇崈隀褈緟蝩蟲爞宠埫寵" +
+		"铳揰銃抽婤搊瘳篘犨犫仇怞俦帱栦惆紬绸菗椆畴絒愁皗稠筹裯酧綢踌儔雔嚋嬦幬懤薵燽雠疇" +
+		"籌躊醻讎讐丑丒吜杻杽侴偢瞅醜矁魗臭臰遚殠酬出岀初摴樗貙齣刍除芻厨滁蒢豠锄媰耡蒭蜍" +
+		"趎鉏雏犓蕏廚篨鋤橱幮櫉藸躇雛櫥蹰鶵躕処杵础椘储楮褚濋儲檚礎齭鸀齼亍处竌怵拀绌豖柷" +
+		"欪竐俶敊畜埱珿絀處傗琡鄐搐滀蓫触踀閦儊嘼諔憷斶歜臅黜觸矗楚榋橻璴蟵欻歘揣搋膗啜嘬" +
+		"膪踹巛川氚穿剶猭瑏伝传舡舩船圌遄傳椽暷篅輲舛荈喘歂僢踳汌串玔钏釧賗鶨刅疮窓窗牎摐" +
+		"牕瘡窻床牀噇幢闯傸摤磢闖创怆刱剏剙凔創愴吹炊垂倕埀陲捶菙搥棰椎腄槌锤箠錘鎚顀龡旾" +
+		"杶春萅堾媋暙椿瑃箺蝽橁輴膥櫄鰆鶞纯陙唇浱純莼淳脣湻犉滣蒓漘蓴醇醕錞鯙偆萶惷睶賰蠢" +
+		"鹑鶉逴踔戳辶辵娕娖婼惙涰绰腏辍酫綽趠輟龊擉磭繛歠嚽齪鑡呲疵赼趀偨跐縒骴髊蠀齹词珁" +
+		"垐柌祠茈茨堲瓷詞辝慈甆辞磁雌鹚糍辤飺餈嬨濨薋鴜礠辭鶿鷀此佌泚玼皉紪鮆朿次伺佽刺刾" +
+		"庛茦栨莿絘蛓赐螆賜匆囪囱苁忩枞怱悤棇焧葱漗聡蓯蔥骢暰樅樬熜瑽璁緫聦聪燪瞛篵聰蟌鍯" +
+		"繱鏦騘驄从丛従婃孮徖從悰淙琮慒漎潀潨誴賨賩樷藂叢灇欉爜憁謥茐凑湊腠辏輳粗觕麁麄麤" +
+		"徂殂促猝脨酢瘄蔟誎趗噈憱踧醋瘯簇縬蹙鼀蹴蹵顣汆撺鋑镩蹿攛躥鑹櫕巑欑穳窜殩熶篡簒竄" +
+		"爨崔催凗缞墔嶉慛摧榱獕槯磪縗鏙漼璀趡皠伜忰疩倅粋紣翆脃脆啐啛悴淬萃毳焠脺瘁粹綷翠" +
+		"膵膬濢竁襊顇臎乼邨村皴踆澊竴存侟拵刌忖寸吋籿搓瑳遳磋撮蹉醝虘嵯嵳痤睉矬蒫蔖鹾酂鹺" +
+		"躦脞剉剒厝夎挫莝莡措逪斮棤锉蓌错歵銼錯",
+	// 'D'
+	"咑哒耷荅笚嗒搭褡噠撘鎝达迖呾妲怛沓炟羍荙畗剳匒畣笪逹答詚達阘靼薘鞑蟽鎉躂鐽韃龖龘" +
+		"打大汏眔垯瘩墶燵繨呆呔獃懛歹逮傣代轪垈岱帒甙绐迨骀带待怠柋殆玳贷帯軑埭帶紿袋軚貸" +
+		"軩瑇廗叇曃緿鴏戴艜黛簤蹛瀻霴襶黱靆鮘丹妉单担単眈砃耼耽郸聃躭單媅殚瘅匰箪褝鄲頕儋" +
+		"勯擔殫甔癉襌簞聸伔刐抌玬瓭胆衴疸紞掸赕亶撢撣澸黕膽黮旦但帎沊狚诞柦疍啖啗弹惮淡萏" +
+		"蛋啿弾氮腅蜑觛窞誕僤噉馾髧嘾彈憚憺暺澹禫蓞駳鴠癚嚪繵贉霮饏泹当珰裆筜當噹澢璫襠簹" +
+		"艡蟷挡党谠擋譡黨攩灙欓讜氹凼圵宕砀垱荡档菪婸愓瓽逿嵣雼潒碭儅瞊蕩趤壋檔璗盪礑簜蘯" +
+		"闣铛鐺刀刂叨忉朷氘舠釖鱽魛捯导岛島捣祷禂搗隝嶋嶌導隯壔嶹擣蹈禱到倒悼焘盗菿盜道稲" +
+		"箌翢噵稻衜檤衟燾翿軇瓙纛屶陦椡槝嘚恴淂惪棏锝徳德鍀地的得脦扥扽灯登豋噔嬁燈璒竳簦" +
+		"覴蹬朩等戥邓凳鄧隥墱嶝瞪磴镫櫈鐙艠氐仾低奃彽袛羝隄堤趆滴樀镝磾鍉鞮廸狄籴苖迪唙敌" +
+		"涤荻梑笛觌靮滌馰髢嘀嫡翟蔋蔐頔敵篴嚁藡豴蹢鬄鏑糴覿鸐厎坘诋邸阺呧底弤抵拞茋柢牴砥" +
+		"埞掋菧觝詆軧聜骶坔弟旳杕玓怟俤帝埊娣递逓偙啇啲梊焍珶眱祶第菂谛釱媂棣渧睇缔蒂僀禘" +
+		"腣遞鉪墑墬摕碲蔕蝃遰慸甋締嶳諦踶螮鯳嗲甸敁掂傎厧嵮滇槇槙瘨颠蹎巅顚顛癫巓巔攧癲齻" +
+		"典奌点婰猠敟跕碘蒧蕇踮點嚸电佃阽坫店垫扂玷钿婝惦淀奠琔殿蜔電墊壂橂橝澱靛癜簟驔椣" +
+		"刁叼汈虭凋奝弴彫蛁琱貂碉鳭殦瞗雕鮉鲷鼦鯛鵰扚屌弔伄吊钓窎訋调掉釣铞铫竨蓧銱雿魡調" +
+		"瘹窵鋽藋鑃簓爹跌褺苵迭垤峌恎挕昳绖胅瓞眣戜谍喋堞惵揲畳絰耋臷詄趃镻叠殜牃牒嵽碟蜨" +
+		"褋艓蝶諜蹀鲽曡疉鰈疊氎哋耊眰幉疂丁仃叮帄玎疔盯钉耵虰酊釘靪奵顶頂鼎嵿鼑濎薡鐤订忊" +
+		"饤矴定訂飣啶铤椗腚碇锭碠蝊鋌錠磸顁萣聢丟丢铥銩东冬咚岽東苳昸氡倲鸫埬娻崠崬涷笗菄" +
+		"徚氭蝀鴤鼕鯟鶇董墥嬞懂箽蕫諌动冻侗垌姛峒恫挏栋洞胨迵凍戙胴動硐棟湩絧腖働駧霘鮗鶫" +
+		"吺唗都兜兠蔸橷篼阧抖枓枡陡唞蚪鈄斗豆郖浢荳逗饾鬥梪毭脰酘痘閗窦鬦餖斣闘竇鬪鬭鬬乧" +
+		"艔厾剢阇嘟督醏闍毒独涜读渎椟牍犊碡裻読蝳獨錖凟匵嬻瀆櫝殰牘犢瓄皾騳黩讀豄贕韣髑鑟" +
+		"韇韥黷讟笃堵帾琽赌睹覩賭篤芏妒杜肚妬度荰秺渡靯镀螙殬鍍簵蠧蠹耑偳剬媏端褍鍴短段断" +
+		"塅缎葮椴煅瑖腶碫锻緞毈簖鍛斷躖籪襨垖堆塠嵟痽磓鴭鐜頧队对兊兌兑対祋怼陮隊碓綐對憞" +
+		"憝濧薱镦懟瀩譈鐓吨惇敦蜳墩墪撴獤噸撉橔犜礅蹲蹾驐盹趸躉伅囤庉沌炖盾砘逇钝顿遁鈍楯" +
+		"頓遯潡燉踲碷多夛咄哆畓剟崜掇敠毲裰嚉夺铎剫敓敚喥悳敪痥鈬奪凙踱鮵鐸朶哚垛垜挅挆埵" +
+		"缍椯趓躱躲憜綞亸鍺軃嚲奲刴剁陊陏饳尮柁柮炨桗堕舵惰跢跥跺飿墮嶞墯鵽朵枤",
+	// 'E'
+	"妸妿娿婀屙钶痾讹吪囮迗俄娥峨峩涐莪珴訛皒睋鈋锇鹅蛾磀誐頟额魤隲額鵝鵞譌鰪枙砈頋噁" +
+		"騀厄屵戹歺岋阨呃扼苊阸呝砐轭咢咹垩姶峉匎恶砨蚅饿偔卾堊悪掠略硆谔軛鄂阏堮崿惡愕湂" +
+		"萼豟軶遌遏鈪廅搤搹琧腭詻僫蝁锷魥鹗蕚頞颚餓噩覨諤閼餩貖鍔鳄歞顎礘櫮鰐鶚讍齃鑩齶鱷" +
+		"擜鵈诶誒奀恩蒽煾峎摁鞥儿而児侕兒陑峏洏荋栭胹唲袻鸸粫聏輀鲕隭髵鮞鴯轜厼尒尓尔耳迩" +
+		"洱饵栮毦珥铒爾餌駬薾邇趰二弍弐佴刵咡贰貮衈貳誀鉺樲",
+	// 'F'
+	"发沷発傠發酦彂醱乏伐姂垡浌疺罚茷阀栰砝筏瞂罰閥罸橃藅佱法灋珐琺髪蕟髮鍅帆訉番勫噃" +
+		"嬏幡憣蕃旙旛繙翻藩轓颿籓飜鱕凡凢凣忛杋柉矾籵钒烦舧笲棥渢煩緐墦樊橎燔璠膰薠繁襎羳" +
+		"蹯瀪瀿礬蘩鐇鐢蠜鷭反払返釩氾犯奿汎泛饭范贩畈軓婏梵盕笵販軬飯飰滼嬎範舤匚方邡汸芳" +
+		"枋牥钫淓蚄鈁鴋防妨房肪埅鲂魴鰟仿访彷纺昉昘瓬眆倣旊紡舫訪髣鶭放趽坊堏錺飞妃非飛啡" +
+		"婓渄绯菲扉猆靟裶緋蜚霏鲱餥馡騑騛飝肥淝腓蜰蟦朏匪诽奜悱斐棐榧翡蕜誹篚吠芾废杮沸狒" +
+		"肺昲胇费俷剕厞疿陫屝萉廃費痱镄廢曊癈鼣濷櫠鯡鐨靅婔暃分吩帉纷芬昐氛哛衯兺紛翂兝棻" +
+		"訜酚鈖雰朆燓餴饙坟妢岎汾朌枌炃肦羒蚠蚡梤棼焚蒶馚隫墳幩濆蕡魵橨燌豮鼢羵鼖豶轒鐼馩" +
+		"黂粉黺份弅奋忿秎偾愤粪僨憤奮膹糞鲼瀵鱝竕躮丰风仹凨凬妦沣沨凮枫封疯盽砜風峯峰偑桻" +
+		"烽崶猦葑锋楓犎蜂瘋碸僼篈鄷鋒檒闏豐鏠酆寷灃蘴霻蠭靊飌麷冯夆捀浲逢堸馮摓漨綘艂讽覂" +
+		"唪諷凤奉甮俸湗焨煈缝赗鳯鳳鴌縫賵琒溄鎽蘕覅仏坲梻紑裦缶否妚缹缻殕雬鴀伕邞呋妋姇玞" +
+		"肤怤柎砆荂衭垺娐尃荴旉紨趺麸痡稃跗鈇筟綒鄜孵豧敷膚鳺麩糐麬麱懯乀巿弗伏凫甶佛冹刜" +
+		"孚扶芙芣咈岪彿怫拂服枎泭绂绋苻茀俘垘柫氟洑炥玸畉畐祓罘茯郛韨哹栿浮砩莩蚨匐桴涪烰" +
+		"琈符笰紱紼翇艴菔虙幅棴絥罦葍福粰綍艀蜉辐鉘鉜颫鳧榑稪箙韍幞澓蝠髴鴔諨踾輻鮄癁襆黻" +
+		"鵩鶝呒抚乶府弣拊斧俌俛胕郙鳬俯釜釡捬辅焤盙腑滏蜅腐輔嘸撨撫頫鬴簠黼阝父讣付妇负附" +
+		"坿竎阜驸复峊祔訃負赴蚥袝陚偩冨副婦蚹媍富復秿萯蛗詂赋圑椱缚腹鲋複褔赙緮蕧蝜蝮賦駙" +
+		"嬔縛輹鮒賻鍑鍢鳆覆馥鰒夫甫咐袱酜傅椨覄禣鮲",
+	// 'G'
+	"旮呷嘎嘠钆尜噶錷尕玍尬魀侅该郂陔垓姟峐荄晐赅畡祴絯該豥賅忋改絠丐乢匃匄阣杚钙盖摡" +
+		"溉葢鈣隑戤概槩蓋賌漑槪瓂甘忓芉迀攼杆玕肝坩泔矸苷乹柑竿疳酐乾粓亁凲尲尴筸漧鳱尶尷" +
+		"魐仠扞皯秆衦赶敢桿笴稈感澉趕橄擀簳鰔鳡鱤干旰汵盰绀倝凎淦紺詌骭幹榦檊贑赣贛灨冈罓" +
+		"冮刚杠纲肛岡牨疘矼缸钢剛罡堈掆釭棡犅堽綱罁鋼鎠岗崗港焵筻槓戅戆皋羔羙高皐髙臯滜槔" +
+		"睾膏槹橰篙糕餻櫜鷎鼛鷱夰杲菒搞缟暠槀槁稾稿镐縞藁檺藳吿告勂叝诰郜祮祰锆煰筶禞誥鋯" +
+		"韟戈仡圪犵纥戓肐牫疙咯牱哥胳袼鸽割搁滒戨歌鴐鴚擱謌鴿鎶呄佮匌挌茖阁革敋格鬲愅臵葛" +
+		"蛒裓隔嗝塥滆觡搿槅膈閣閤獦镉鞈韐骼諽輵鮯韚轕鞷騔哿舸个各虼個硌铬嗰箇彁櫊给給根跟" +
+		"哏艮亘亙茛揯刯庚畊浭耕菮搄焿絚赓鹒緪縆羮賡羹鶊郠哽埂峺挭绠耿莄梗綆鲠骾鯁更堩暅掶" +
+		"椩工弓公厷功攻杛供玜糼肱宫宮恭躬龚匑塨幊愩觥躳熕碽髸觵龏龔廾巩汞拱拲栱珙輁鋛鞏共" +
+		"贡羾唝貢莻蚣慐勾佝沟钩袧缑鈎溝鉤緱褠篝鞲韝芶岣狗苟枸玽耇耉笱耈蚼豿坸构诟购垢姤茩" +
+		"冓够夠訽媾彀搆詬遘雊構煹觏撀覯購估呱姑孤沽泒苽柧轱唂罛鸪笟菰蛄觚軱軲辜酤鈲箍箛嫴" +
+		"橭鮕鴣鶻夃古扢汩诂谷股牯骨唃罟羖钴啒淈脵蛊蛌尳愲蓇詁馉鹄榾毂鈷鼓鼔嘏榖皷鹘穀縎糓" +
+		"薣濲皼臌轂餶瀔盬瞽蠱固故凅顾堌崓崮梏牿棝祻雇痼稒锢僱錮鲴鯝顧咕峠逧傦菇篐瓜刮胍栝" +
+		"鸹歄煱聒趏劀緺踻銽颳鴰騧冎叧剐剮寡卦坬诖挂啩掛罣絓罫褂詿颪乖掴摑拐枴柺箉夬叏怪恠" +
+		"关观官冠覌倌棺蒄窤関瘝癏観闗鳏關鰥觀鱞莞馆琯痯筦管輨舘錧館鳤毌丱贯泴悺惯掼涫貫悹" +
+		"祼慣摜潅遦樌盥罆雚鏆灌爟瓘矔礶鹳罐鑵鱹鸛光灮侊炗炛咣垙姯洸茪桄烡胱僙輄銧黆广広犷" +
+		"廣獷臩俇珖逛臦撗炚欟归圭妫龟规邽皈茥闺帰珪胿亀傀硅窐袿規媯廆椝瑰郌嫢摫閨鲑嬀槻槼" +
+		"螝璝膭鮭龜巂歸鬶騩瓌鬹櫷宄氿朹轨庋佹匦诡陒垝姽恑攱癸軌鬼庪祪匭晷湀蛫觤詭厬瞡簋蟡" +
+		"攰刽刿昋柜炔贵桂桧猤筀貴蓕跪匱劊劌嶡撌槶檜瞶禬簂櫃癐襘鳜鞼鱖鱥椢丨衮惃绲袞袬辊滚" +
+		"蓘滾緄蔉磙輥鲧鮌鯀棍睔睴璭謴呙咼埚郭堝崞鈛锅墎瘑嘓彉濄蝈鍋彍蟈囯囶囻国圀國帼腘幗" +
+		"慖漍聝蔮膕虢馘果惈淉猓菓馃椁槨粿綶蜾裹輠錁餜鐹过過啯",
+	// 'H'
+	"哈铪蛤奤丷咍咳嗨还孩頦骸還海胲烸酼醢亥妎骇害氦嗐餀駭饚塰嚡佄炶顸蚶酣頇嫨谽憨馠歛" +
+		"鼾邗含邯函咁肣凾虷唅圅娢浛崡晗梒涵焓琀寒嵅韩甝筨蜬澏鋡魽韓丆厈罕浫喊蔊阚豃鬫汉屽" +
+		"汗闬旱岾哻垾悍捍涆猂莟晘晥焊菡釬閈皔睅傼蛿颔馯撖漢蜭貋暵熯銲鋎憾撼翰螒頷顄駻譀雗" +
+		"瀚蘫鶾兯爳夯苀迒斻杭绗珩笐航蚢颃貥筕絎頏魧沆垳茠蒿嚆薅薧毜蚝毫椃嗥獆貉噑獔豪嘷獋" +
+		"諕儫嚎壕濠籇蠔譹好郝号昊昦秏哠峼恏悎浩耗晧淏傐皓鄗滈聕號暤暭澔皜皞曍皡薃皥鎬颢灏" +
+		"顥鰝灝竓诃抲欱喝訶嗬蠚禾合何劾厒咊和姀河郃峆曷柇狢盇籺紇阂饸哬敆核盉盍荷啝涸渮盒" +
+		"秴菏萂蚵龁惒訸颌楁毼澕詥貈輅鉌阖鲄熆鹖麧頜篕翮螛魺礉闔鞨齕覈鶡皬鑉龢佫垎贺袔焃賀" +
+		"嗃煂碋熇褐赫鹤穒翯壑癋謞爀鶮鶴靎鸖靏粭靍黒黑嘿潶拫痕鞎佷很狠詪恨亨哼悙啈脝姮恆恒" +
+		"桁烆胻鸻横橫衡鴴蘅鑅堼涥鵆噷叿吽呍灴轰哄訇烘軣揈渹焢硡谾薨輷嚝鍧轟仜弘妅红吰宏汯" +
+		"玒纮闳宖泓苰垬娂洪竑紅荭虹峵浤紘翃耾硔紭谹鸿渱竤粠葒葓鈜閎綋翝谼潂鉷鞃魟鋐彋蕻霐" +
+		"黉霟鴻黌晎嗊讧訌閧撔澋澒銾闂鬨齁侯矦鄇喉帿猴葔瘊睺篌糇翭骺翵鍭餱鯸吼犼后郈厚垕後" +
+		"洉逅堠豞鲎鲘鮜鱟候乯匢虍呼垀忽昒曶泘苸恗烀轷匫唿惚淴虖軤嘑寣滹雐幠戯歑膴謼囫抇弧" +
+		"狐瓳胡壶隺壷斛焀喖壺媩搰湖猢絗葫楜煳瑚嘝蔛鹕槲箶蝴衚魱縠螜醐頶觳鍸餬鵠瀫鬍鰗鶘鶦" +
+		"乕汻虎浒俿萀琥虝滸乥互弖戶户戸冱冴芐帍护沍沪岵怙戽昈枑怘祜笏婟扈瓠楛嗀綔鄠雽嫭嫮" +
+		"摢滬蔰槴熩鳸簄鍙嚛鹱護鳠韄頀鱯鸌乎粐唬糊錿鯱花芲哗嘩蒊錵华姡骅華釪釫铧滑猾搳撶磆" +
+		"蕐螖鋘譁鏵驊鷨化划夻杹画话崋桦婳畫嬅畵觟話劃摦樺嫿槬澅諣黊繣舙譮埖婲椛硴糀璍誮怀" +
+		"徊淮槐褢踝懐褱懷瀤櫰耲蘹坏咶諙壊壞蘾犿歓鴅鵍酄嚾懽獾讙貛驩环郇峘洹狟荁桓萈萑寏絙" +
+		"雈綄羦貆鉮锾圜嬛寰澴缳阛環豲鍰镮鹮糫繯轘鐶闤鬟瓛缓緩攌幻奂肒奐宦唤换浣涣烉患梙焕" +
+		"逭喚喛嵈愌換渙痪睆煥瑍豢漶瘓槵鲩擐澣藧鯇鰀欢瞣歡巟肓荒衁朚塃慌皇偟凰隍黄喤堭媓崲" +
+		"徨惶湟葟遑黃楻煌瑝墴潢獚锽熿璜篁篊艎蝗癀磺穔諻簧蟥鍠餭鳇趪韹鐄騜兤鰉鱑鷬怳恍炾宺" +
+		"晄奛谎幌詤熀謊櫎愰滉榥曂皝鎤皩晃縨灰诙咴恢拻挥洃虺袆晖烣珲豗婎媈揮翚辉隓暉楎煇禈" +
+		"詼幑睳褘噅撝噕翬輝麾徽隳瀈蘳鰴囘回囬佪廻廽恛洄茴迴烠蚘逥痐蛔蛕蜖鮰悔毀毁毇檓燬譭" +
+		"卉汇会讳泋哕浍绘芔荟诲恚恵烩贿彗晦秽喙惠湏絵缋翙阓匯彙彚會滙詯賄颒僡嘒瘣蔧誨圚寭" +
+		"慧憓暳槥潓蕙噦嬒徻橞殨澮濊獩薈薉諱頮燴璯篲藱餯嚖瞺穢繢蟪櫘繪翽譓儶鏸闠孈鐬靧譿顪" +
+		"屷灳璤懳昏昬荤婚惛涽阍棔殙葷睧睯閽忶浑梡馄堚渾琿魂餛繉轋鼲鯶诨俒倱圂掍混焝溷慁觨" +
+		"諢吙剨耠锪劐嚄鍃豁攉騞佸活秮秳火伙邩钬鈥漷夥沎或货咟砉俰捇眓获閄掝祸貨惑旤楇湱禍" +
+		"蒦奯濩獲霍檴謋矆穫镬嚯瀖耯艧藿蠖嚿曤臛癨矐鑊靃",
+	// 'I'
+	"",
+	// 'J'
+	"丌讥击刉叽饥乩刏圾机玑肌芨矶鸡枅咭姫迹剞唧姬屐积笄飢基绩喞嵆嵇敧朞犄筓缉赍勣嗘畸" +
+		"稘跡跻鳮僟毄箕銈嘰槣畿稽緝觭賫躸齑墼機激璣禨積襀錤隮擊磯簊績羁賷鄿櫅耭蹟雞譏韲鶏" +
+		"譤鐖饑躋鞿鷄齎羇虀鑇覉鑙齏羈鸄覊亼及伋吉岌彶忣汲级即极皀亟佶诘郆钑卽姞急狤皍笈級" +
+		"揤疾脊觙偮卙庴焏谻戢棘極殛湒集塉嫉愱楫蒺趌槉禝耤膌銡嶯撃潗濈瘠箿蕀蕺踖鹡橶檝螏擮" +
+		"藉襋蹐鍓艥籍轚鏶霵鶺鷑雦雧几己丮妀犱泲虮挤掎鱾幾戟鈘嵴麂魢撠擠穖蟣魕彐彑旡计记伎" +
+		"纪坖妓忌技芰际剂季哜垍峜既洎济紀茍茤荠計剤紒继觊記偈寂寄徛悸旣梞済祭塈惎臮葪蔇兾" +
+		"痵継蓟裚褀際鬾暨漃漈稩穊誋跽霁鲚暩稷諅鲫冀劑曁穄薊髻嚌檕濟繋罽薺覬檵鵋齌懻癠穧蘎" +
+		"骥鯚瀱繼蘮鱀蘻霽鰶鰿鱭驥亽辑樭輯廭癪加乫夹伽夾抸佳拁泇茄迦枷毠浃珈埉家浹痂梜笳耞" +
+		"袈傢猳葭跏犌腵鉫嘉鉿镓豭貑鎵麚圿忦扴郏荚郟唊恝莢戛袷铗戞蛱裌颊蛺跲鞂餄鋏頬頰鴶鵊" +
+		"甲仮岬叚玾胛斚贾钾假婽徦斝椵賈鉀榎槚瘕檟价驾架嫁幏榢價駕稼糘戋奸尖幵坚歼间冿戔玪" +
+		"肩艰姦姧兼监偂堅惤猏笺菅菺豜湔牋犍缄葌間搛椷椾煎瑊睷碊缣蒹豣監箋樫熞緘蕑蕳鲣鳽鹣" +
+		"熸篯縑艱鞬餰馢麉瀐鞯鳒礛覸鵳瀸鐧櫼殲鶼韀鰹囏虃鑯韉囝拣枧俭柬茧倹挸捡笕减剪梘检湕" +
+		"趼堿揀揃検減睑硷裥詃锏弿暕瑐筧简絸谫戩戬碱儉翦撿檢藆襇襉謇蹇瞼礆簡繭謭鬋鰎鹸瀽蠒" +
+		"鐗劗鹻籛譾襺鹼见件見建饯剑洊牮荐贱俴健剣栫涧珔舰剱徤渐袸谏釼寋旔楗毽溅腱臶葥践賎" +
+		"鉴键僭榗漸蔪劍劎澗箭糋諓賤趝踐踺劒劔薦諫鋻鍵餞瞷磵螹鍳擶濺繝瀳覵鏩艦譼轞鐱鑑鑒鑬" +
+		"鑳彅墹橺礀殱江姜将茳浆畕豇將葁畺摪翞僵漿螀壃缰薑橿殭螿鳉疅礓疆繮韁鱂讲奖桨傋蒋奨" +
+		"奬蔣槳獎耩膙講顜匞夅弜降洚绛弶袶絳酱勥滰嵹摾彊犟糡醤糨醬謽匠杢櫤艽芁交郊姣娇峧浇" +
+		"茭茮骄胶椒焦蛟跤僬嘄虠鲛嬌嶕嶣憍澆膠蕉燋膲礁穚鮫鵁鹪簥蟭轇鐎鷍驕鷦鷮臫角佼侥恔挢" +
+		"狡绞饺捁晈烄皎矫脚铰搅湫絞剿敫湬煍腳賋僥摷暞踋鉸餃儌劋徺撟撹隦徼憿敽敿燞缴曒璬矯" +
+		"皦蟜繳譑孂攪灚鱎叫呌峤挍訆珓窌轿较敎教窖滘較嘂嘦斠漖酵噍嶠潐噭嬓獥藠趭轎醮譥皭釂" +
+		"鵤櫵纐阶疖皆接掲痎秸菨階喈嗟堦媘嫅揭椄湝脻街煯稭擑蝔癤謯鶛卩卪孑尐节讦刦刧劫岊昅" +
+		"刼劼杰疌衱拮洁结迼倢桀莭訐偼婕崨捷袺傑喼結絜颉嵥楬楶滐睫節蜐蝍詰鉣魝截榤碣竭蓵鲒" +
+		"潔羯誱踕鞊幯鍻鮚巀櫭蠞蠘蠽毑媎解觧飷檞丯介吤岕庎戒芥屆届玠界畍疥砎衸诫借悈蚧徣堺" +
+		"楐琾蛶骱犗誡褯魪鎅躤姐桝巾今斤钅兓金津矜荕衿觔埐珒紟惍堻筋釿嶜鹶黅襟仅尽侭卺巹紧" +
+		"堇菫僅厪谨锦嫤廑漌盡緊蓳馑槿瑾儘錦謹饉伒劤劲妗近进枃勁浕荩晉晋浸烬赆唫琎祲進寖搢" +
+		"溍禁缙靳墐暜瑨僸凚歏殣璡觐噤濅縉賮嚍嬧濜藎燼璶覲贐齽釒砛琻壗坕坙巠京泾经茎亰秔荆" +
+		"荊涇莖婛惊旌旍猄経菁晶稉腈葏粳經兢精聙鲸鵛鯨鶁鶄麖鼱驚麠井丼阱刭坓宑汫汬肼剄穽颈" +
+		"景儆頚幜憬憼暻燛璟璥頸蟼警妌净弪径迳俓婙浄胫倞凈弳徑痉竞逕婧桱梷淨竫脛竟敬痙竧靓" +
+		"傹靖境獍誩踁静靚曔镜靜濪瀞鏡競竸睛橸燝冂冋坰扃埛絅駉駫蘏蘔冏囧泂炅迥侰炯逈浻烱煚" +
+		"窘颎綗僒煛熲澃褧丩勼纠朻牞究糺鸠糾赳阄萛啾揂揪揫鳩摎樛鬏鬮九久乆乣奺灸玖舏韭紤酒" +
+		"镹韮匛旧臼咎疚柩柾倃捄桕匓厩救媨就廄廐舅僦廏慦殧舊鹫匶鯦麔齨鷲汣杦欍凥刟抅匊居拘" +
+		"泃狙苴驹挶疽痀眗砠罝陱娵婮崌掬梮涺菹椐琚腒趄跔锔裾雎艍蜛踘踙鋦駒鮈鴡鞠鞫鶋局泦侷" +
+		"狊桔毩啹婅淗焗菊郹椈毱湨犑輂僪粷跼閰諊趜躹橘檋駶鵙蹫鵴巈蘜鶪鼳驧咀弆沮举莒挙椇筥" +
+		"榉榘蒟龃聥舉踽擧櫸齟欅巨句乬巪讵姖岠怇拒洰苣邭具怐怚拠昛歫炬秬钜俱倨倶冣剧粔耟蚷" +
+		"袓埧埾惧据詎距犋跙鉅飓虡豦锯寠愳窭聚駏劇勮屦踞鮔壉懅據澽窶遽鋸屨颶貗簴躆醵懼鐻矩" +
+		"爠襷姢娟捐涓焆瓹脧裐鹃勬镌鎸鵑鐫蠲卷呟帣埍捲菤锩臇錈奆劵巻倦勌桊狷绢隽淃眷鄄睊絭" +
+		"罥雋睠絹飬慻蔨餋獧縳羂噘撅撧屩蹻亅孒孓决刔氒诀弡抉決芵泬玦玨挗珏疦砄绝虳觉倔捔欮" +
+		"蚗崛掘斍桷殌覐觖訣赽趹逫傕厥焳絕絶覚趉鈌劂勪瑴谲駃嶥憰熦爴獗瘚蕝蕨鴂鴃噱憠橛橜爵" +
+		"臄镢蟨蟩屫爑譎蹶蹷鶌匷嚼矍覺鐍鐝爝觼彏戄攫玃鷢欔矡龣貜躩钁军君均汮姰袀軍钧莙蚐桾" +
+		"皲菌鈞碅皸皹覠銁銞鲪麇鍕鮶麏麕呁俊郡陖埈峻捃浚馂骏晙焌珺棞畯竣儁箘箟蜠寯懏餕燇濬" +
+		"駿鵔鵘攈攟",
+	// 'K'
+	"咔咖喀衉擖卡佧胩鉲垰裃开奒揩锎開鐦凯剀垲恺闿铠凱剴嘅慨蒈塏嵦愷楷輆暟锴鍇鎧闓颽忾" +
+		"炌炏欬烗勓愒愾鎎刊栞勘龛堪嵁戡龕冚坎侃砍莰偘埳惂欿塪歁槛輡檻顑竷轗看衎崁墈瞰磡闞" +
+		"矙忼闶砊粇康嫝嵻慷漮槺穅糠躿鏮鱇扛摃亢伉匟邟囥抗犺炕钪鈧閌尻髛丂攷考拷洘栲烤稁鲓" +
+		"燺铐犒銬靠鮳鯌匼苛柯牁珂科胢轲疴砢趷棵萪軻颏嗑搕犐稞窠鈳榼薖颗樖瞌磕蝌錒醘顆髁礚" +
+		"壳揢殼翗可坷岢炣渇嵑敤渴嶱礍克刻剋勀勊客恪娔尅课堁氪骒缂愙溘锞碦緙艐課礊騍嵙肎肯" +
+		"肻垦恳啃豤龈墾錹懇齦掯裉褃劥阬吭坑妔挳硁牼硜铿硻摼誙銵鍞鏗空倥埪崆悾涳硿箜錓鵼孔" +
+		"恐控鞚躻抠芤眍剾彄摳瞘口劶叩扣敂冦宼寇釦窛筘滱蔲蔻瞉簆鷇扝刳矻郀枯胐哭桍堀崫圐跍" +
+		"窟骷鮬狜苦库俈绔庫秙趶焅袴喾絝裤瘔酷廤褲嚳夸姱誇侉咵垮銙挎胯跨骻舿蒯擓巜凷块快侩" +
+		"郐哙狯脍塊筷鲙儈墤鄶噲廥獪膾旝糩鱠圦宽寛寬臗髋髖欵款歀窾窽鑧匡劻诓邼匩哐恇洭框硄" +
+		"筐誆軭忹抂狂诳軖誑鵟夼儣懭卝邝圹纩况旷岲況矿昿贶眖眶絖貺軦鉱鄺壙黋懬曠爌躀矌礦穬" +
+		"纊鑛砿絋筺亏刲岿悝盔窥聧窺虧顝闚巋蘬奎晆逵鄈隗頄馗喹揆葵骙戣暌楏楑魁睽蝰頯櫆藈鍨" +
+		"鍷騤夔蘷巙虁犪躨煃跬頍蹞尯匮欳喟媿愦愧溃腃蒉馈瞆嘳嬇憒潰篑聩聭蕢樻謉餽簣聵籄鐀饋" +
+		"鑎坤昆堃婫崐崑晜猑菎裈焜琨髠裩貇锟髡鹍蜫褌髨瑻醌錕鲲騉鯤鵾鶤悃捆阃壸梱祵硱稇裍壼" +
+		"稛綑閫閸齫困涃睏堒尡潉熴扩拡括挄桰筈萿葀蛞阔廓頢髺擴濶闊鞟懖霩鞹鬠韕",
+	// 'L'
+	"垃拉柆翋菈搚邋旯剌砬揦磖喇藞腊揧楋瘌蜡蝋辢辣蝲臈攋爉臘鬎瓎镴鯻蠟鑞啦溂鞡嚹来來俫" +
+		"倈崃徕涞莱郲婡崍庲徠梾淶猍萊逨棶琜筙铼箂錸騋鯠鶆麳唻赉睐睞赖賚濑賴頼顂癞鵣瀨瀬籁" +
+		"藾櫴癩襰籟兰岚拦栏婪惏嵐葻阑蓝谰厱澜褴儖斓篮懢燣燷藍襕镧闌璼襤譋幱攔瀾灆籃繿蘭斕" +
+		"欄礷襴囒灡籣欗讕躝钄韊览浨揽缆榄漤罱醂壈懒覧擥嬾懶孄覽孏攬灠囕欖顲纜烂滥燗嚂濫爁" +
+		"爛瓓爤鑭糷爦襽啷勆郎郞欴狼阆嫏廊斏桹琅蓈榔瑯硠稂锒筤艆蜋螂躴鋃鎯駺朗朖烺塱蓢樃誏" +
+		"朤埌崀浪莨蒗閬唥郒捞撈劳労牢窂哰唠崂浶勞痨铹僗嘮嶗憥癆磱簩蟧醪鐒顟髝耂老佬咾姥恅" +
+		"狫荖栳铑銠潦橑轑涝烙耢酪嫪憦澇躼橯耮軂珯硓粩蛯朥鮱肋仂阞乐叻忇扐氻艻玏泐竻砳楽韷" +
+		"樂簕鳓鰳了饹餎勒雷嫘缧蔂畾擂檑縲礌镭櫑瓃羸礧纍罍蘲蠝鐳轠儽壨鑘靁虆欙纝鼺厽耒诔垒" +
+		"絫腂傫誄樏磊蕌磥蕾儡壘癗藟櫐礨灅蘽讄鑸鸓泪洡类涙淚累酹銇頛頪錑攂颣類纇蘱禷塁嘞鱩" +
+		"崚塄棱楞碐稜輘薐冷倰堎愣睖踜刕杝厘剓离荲骊悡梨梩梸犁琍粚菞喱棃犂鹂剺漓睝筣缡艃蓠" +
+		"蜊嫠孷樆璃盠貍糎蔾褵鋫鲡黎篱縭罹錅蟍謧醨嚟藜邌釐離斄瓈鏫鯬鵹黧囄攡灕蘺蠡騹孋廲劙" +
+		"鑗穲籬纚驪鱺鸝礼里俚峛峢娌峲浬逦理锂粴裏豊鋰鲤兣澧禮鯉蟸醴鳢邐鱧欚力历厉屴立吏朸" +
+		"丽利励呖坜沥苈例岦戾枥沴疠苙隶俐俪栎疬砅茘荔赲轹郦唎悧栗栛涖猁珕砺砾秝莅莉唳婯笠" +
+		"粒粝脷蚸蛎傈凓厤棙痢蛠詈跞雳厯塛慄搮溧蒚蒞鉝鳨厲暦歴瑮綟蜧蝷勵曆歷篥隷鴗巁濿癘磿" +
+		"隸鬁儮曞櫔爄犡禲蠇鎘嚦壢攊櫟瀝瓅矋礪藶麗櫪爏瓑皪盭礫糲蠣儷癧礰蠫酈鷅麜囇攦觻躒轢" +
+		"欐讈轣攭瓥靂鱱鱳靋李栃哩娳狸裡檪鯏俩倆奁连帘怜涟莲連梿联裢亷嗹廉慩溓漣蓮匲奩槤熑" +
+		"覝劆匳噒嫾憐磏聫褳鲢濂濓縺翴聮薕螊櫣燫聯臁謰蹥鎌镰簾蠊鬑鐮鰱籢籨敛琏脸裣摙璉蔹嬚" +
+		"斂臉鄻襝羷蘞练炼恋浰殓僆堜媡湅萰链楝煉瑓潋練澰錬殮鍊鏈瀲蘝鰊戀纞聨良俍凉梁涼椋辌" +
+		"粮粱墚綡踉樑輬糧両两兩唡啢掚脼裲緉蜽魉魎亮哴悢谅辆喨晾湸量輌諒輛鍄煷簗撩蹽辽疗聊" +
+		"僚寥嵺憀漻膋嘹嫽寮嶚嶛敹獠缭遼暸燎璙膫療鹩屪廫簝繚蟟豂賿蹘鐐髎藔飉鷯叾钌釕鄝蓼憭" +
+		"瞭曢镽爒尥尦炓料尞廖撂窷镣爎列劣冽劽姴挒洌茢迾哷埒埓栵浖烈捩猎脟蛚裂煭睙聗趔巤颲" +
+		"儠鮤鴷擸獵犣躐鬛鬣鱲毟咧挘烮猟拎厸邻林临冧矝啉崊淋晽琳粦痳碄箖粼鄰隣嶙潾獜遴斴暽" +
+		"燐璘辚霖瞵磷臨繗翷麐轔壣瀶鏻鳞驎鱗麟菻亃凛凜撛廩廪懍懔澟檁檩癛癝吝恡悋赁焛賃僯蔺" +
+		"橉甐膦閵疄藺蹸躏躙躪轥〇刢灵囹坽夌姈岺彾泠狑苓昤朎柃玲瓴凌皊砱秢竛铃陵鸰婈掕棂淩" +
+		"琌笭紷绫羚翎聆舲菱蛉衑祾詅跉軨裬鈴閝零龄綾蔆霊駖澪蕶錂魿鲮鴒鹷燯霛霝齢酃鯪孁蘦齡" +
+		"櫺醽靈欞爧麢龗阾岭袊领領嶺令另呤炩伶蓤霗瀮溜熘蹓刘沠畄浏流留旈琉畱硫裗媹嵧旒蒥蓅" +
+		"遛馏骝榴瑠飗劉瑬瘤磂镏駠鹠橊璢疁镠癅蟉駵嚠懰瀏藰鎏鎦麍鏐飀騮飅鰡鶹驑柳栁珋桺绺锍" +
+		"鉚飹綹熮罶鋶橮嬼羀六畂翏塯廇澑磟鹨霤餾雡鐂飂鬸鷚桞囖龙屸咙泷茏昽栊珑胧眬砻竜笼聋" +
+		"隆湰滝嶐漋蕯癃篭龍嚨巃巄瀧簼蘢鏧霳曨朧櫳爖瓏矓礱礲襱龒籠聾蠪蠬豅躘鑨靇驡鸗陇垄垅" +
+		"拢篢儱隴壟壠攏竉龓哢挵梇徿贚槞窿瞜剅娄偻婁溇蒌僂楼廔慺漊蔞遱樓熡耧蝼耬艛螻謱軁髅" +
+		"鞻髏嵝搂塿嶁摟甊篓簍陋屚漏瘘镂瘺瘻鏤喽嘍噜撸卢庐芦垆泸炉栌胪轳鸬玈舻颅鲈魲盧櫚嚧" +
+		"壚廬攎瀘獹璷蘆曥櫨爐瓐臚矑籚纑罏艫蠦轤鑪顱髗鱸鸕黸卤虏掳鹵硵鲁虜塷滷蓾樐魯擄橹磠" +
+		"镥嚕擼瀂櫓氌艣鏀艪鐪鑥圥甪陆侓坴彔录峍勎赂辂陸娽淕淥渌硉菉逯鹿椂琭禄祿僇剹勠盝睩" +
+		"碌稑賂路塶廘摝漉箓粶蔍戮樚熝膔觮趢踛辘醁潞穋蕗錄録錴璐簏螰簶蹗轆騄鹭簬鏕鯥鵦鵱麓" +
+		"鏴露騼籙虂鷺枦舮鈩澛氇驴郘闾榈閭馿氀膢藘鷜驢吕呂侣侶挔捛捋旅梠祣稆铝屡絽缕屢膂褛" +
+		"鋁履膐褸儢穞縷穭寽垏律虑率绿嵂氯葎滤綠緑慮箻膟勴繂濾櫖爈鑢焒娈孪峦挛栾鸾脔滦銮鵉" +
+		"圝奱孌孿巒攣曫欒灓羉臠圞灤虊鑾癴癵鸞卵乱釠亂畧锊稤圙鋝鋢擽抡掄仑伦囵沦纶侖轮倫陯" +
+		"圇婨崘崙惀淪菕棆腀綸蜦踚輪錀鯩埨碖稐耣论溣論磮罗啰頱囉罖猡脶萝逻椤腡覙锣箩骡镙螺" +
+		"羅覶鏍儸覼騾攞玀蘿邏欏驘鸁籮鑼饠剆倮蓏裸躶瘰蠃臝曪癳泺峈洛络荦骆洜珞硦笿絡落嗠摞" +
+		"漯犖鉻雒駱鮥鴼鵅濼纙",
+	// 'M'
+	"呣妈孖媽嬤嬷麻痲蔴犘蟇马玛码蚂馬溤瑪碼螞鎷鰢鷌犸杩祃閁骂唛傌獁睰嘜榪禡罵駡礣鬕亇" +
+		"吗嗎遤嘛嫲蟆埋薶霾买荬買嘪蕒鷶劢迈佅売麦卖脉脈麥衇勱賣邁霡霢嫚颟姏悗蛮僈谩慲馒樠" +
+		"瞒瞞鞔謾饅鳗顢鬗鬘鰻蠻屘満睌满滿螨襔蟎鏋矕曼鄤墁幔慢摱漫獌缦蔄蔓槾熳澷镘縵鏝蘰牤" +
+		"邙吂忙汒芒尨杗杧氓盲恾笀茫哤娏庬浝狵牻硭釯铓痝蛖鋩駹莽莾硥茻壾漭蟒蠎猫貓毛矛枆牦" +
+		"茅茆旄罞兞渵軞酕堥锚嫹髦氂犛蝥髳錨蟊鶜冇卯夘乮戼峁泖昴铆笷蓩冃皃芼冐茂冒柕眊贸耄" +
+		"袤覒媢帽萺貿鄚愗暓楙毷瑁瞀貌鄮蝐懋么麼嚒濹嚜癦呅坆沒没枚玫苺栂眉娒脄莓梅珻脢郿堳" +
+		"媒嵋湄湈猸睂葿楣楳煤瑂禖塺槑酶镅鹛鋂霉穈徾鎇矀攗蘪鶥黴毎每凂美挴浼媄嵄渼媺腜镁嬍" +
+		"燘鎂黣妹抺沬旀昧祙袂眛媚寐痗跊鬽煝睸韎魅篃蝞躾门扪玧钔門閅捫菛璊鍆亹虋闷焖悶暪燜" +
+		"懑懣们們椚甿虻冡莔萌萠盟蒙甍儚橗瞢蕄蝱鄳鄸幪懞濛曚朦檬氋矇礞鯍鹲艨蘉矒霿靀饛顭鼆" +
+		"鸏勐猛瓾锰艋蜢懜獴錳懵蠓鯭孟梦夢溕夣霥掹擝咪眯瞇冞弥罙祢迷猕谜蒾詸謎醚彌擟糜縻麊" +
+		"麋禰靡瀰獼麛镾戂攠瓕蘼爢醾醿鸍釄米芈侎沵羋弭洣敉眫脒渳葞蔝銤濔孊灖冖糸汨沕宓泌觅" +
+		"峚祕宻秘密淧淿覓覔幂谧塓幎覛嘧榓滵漞熐蔤蜜鼏冪樒幦濗藌謐櫁簚羃宀芇眠婂绵媔棉綿緜" +
+		"臱蝒嬵檰櫋矈矊矏丏汅免沔黾勉眄娩偭冕勔渑喕愐湎缅葂絻腼黽緬麫澠鮸靣面糆麪麺麵喵苗" +
+		"媌描瞄鹋緢鶓鱙杪眇秒淼渺缈篎緲藐邈妙庙玅竗庿廟乜吀咩哶孭灭烕覕搣滅蔑薎鴓幭懱篾櫗" +
+		"蠛衊鑖鱴民姄岷忞怋旻旼苠珉盿砇罠崏捪琘缗敯瑉痻碈鈱緍緡錉鴖鍲皿冺刡闵抿泯勄敃闽悯" +
+		"敏笢惽湣閔愍暋閩僶慜憫潣簢鳘蠠鰵垊笽名明鸣洺眀茗冥朙眳铭鄍嫇溟猽蓂暝榠銘鳴瞑螟覭" +
+		"佲姳凕慏酩命椧詺掵谬謬摸谟嫫馍摹模膜麽摩橅磨糢謨嚤擵饃嚩嚰蘑髍魔劘饝抹懡末劰圽妺" +
+		"帓歾歿殁沫茉陌帞昩枺唜皌眜眿砞秣莈莫眽粖絈湐蛨貃嗼塻寞漠獏蓦貊暯銆靺嫼黙瘼瞐瞙镆" +
+		"魩墨默瀎謩貘藦蟔鏌爅驀礳纆耱庅怽尛魹麿哞牟侔劺恈洠眸谋蛑缪踎鉾謀瞴繆鍪鴾麰某毪氁" +
+		"墲母亩牡坶姆峔牳畆畒胟畝畞砪畮鉧踇木仫朰目沐狇炑牧苜毣莯蚞钼募雮墓幕幙慔楘睦鉬慕" +
+		"暮艒霂穆縸鞪凩拇",
+	// 'N'
+	"嗯拏拿挐嗱镎鎿乸哪雫那妠纳肭娜衲钠納袦捺笝豽軜貀鈉蒳靹魶腉熋摨孻乃奶艿氖疓妳廼迺" +
+		"倷釢嬭奈柰耏耐萘渿鼐褦螚錼囡男枏枬侽南柟娚畘莮难喃暔楠諵難赧揇湳萳腩蝻戁婻遖囔乪" +
+		"嚢譨囊蠰鬞馕欜饢擃曩攮灢儾齉孬呶怓挠峱硇铙猱蛲詉碙撓嶩憹蟯夒譊鐃巎垴恼悩脑匘堖惱" +
+		"嫐瑙腦碯獶獿闹婥淖閙鬧臑脳疒讷抐眲訥吶呐呢娞馁脮腇餒鮾鯘內内氝錗恁嫩嫰能妮尼坭怩" +
+		"泥籾倪屔秜郳铌埿婗淣猊蚭棿跜腝聣蜺觬貎輗霓鲵鯓鯢麑齯臡伱你拟抳狔苨柅旎晲孴鈮馜儗" +
+		"儞隬擬薿檷聻屰氼伲迡昵胒逆匿眤堄惄嫟愵溺睨腻暱縌誽膩嬺袮拈蔫年秊秥鲇鮎鲶黏鯰涊捻" +
+		"淰焾跈辇辗撚撵碾輦簐蹍攆蹨躎卄廿念姩唸埝艌鼰哖鵇嬢孃酿醸釀娘鸟茑袅鳥嫋裊蔦樢嬝褭" +
+		"嬲尿脲捏揑苶帇圼枿陧涅痆聂臬啮惗菍隉喦敜湼嗫嵲踂噛摰槷踗镊镍嶭篞臲錜颞蹑嚙聶鎳闑" +
+		"孼孽櫱籋蘖囁齧糱糵蠥鑈囓讘躡鑷顳钀巕囜您拰脌宁咛拧狞苧柠聍寍寕甯寗寜寧儜凝嚀嬣擰" +
+		"獰薴檸聹鑏鬡鸋橣矃佞侫泞濘澝妞牛汼忸扭狃纽炄钮紐莥鈕靵衂牜农侬哝浓脓秾農儂辳噥濃" +
+		"蕽檂燶禯膿穠襛醲欁繷弄挊癑齈羺啂槈耨獳檽鎒鐞譳奴孥驽笯駑伮努弩砮胬怒傉搙女钕籹釹" +
+		"沑恧朒衄奻渜暖煖煗餪疟虐硸瘧黁郍挪梛傩儺橠诺喏掿逽愞搦锘搻榒稬諾蹃糑懦懧糥穤糯",
+	// 'O'
+	"喔噢哦筽讴沤欧殴瓯鸥塸漚歐毆熰甌鴎櫙謳鏂鷗膒齵吘呕偶腢嘔耦蕅藕怄慪藲",
+	// 'P'
+	"妑皅趴舥啪葩杷爬掱琶筢潖帊帕怕袙拍俳徘排猅棑牌輫簰簲犤廹哌派湃蒎鎃眅砙畨潘攀爿洀" +
+		"盘跘媻幋蒰搫槃盤磐縏磻蹒瀊蟠蹣鎜鞶冸判沜拚泮炍叛牉盼畔聁袢詊溿頖鋬襻鑻鵥乓沗胮雱" +
+		"滂膖霶厐庞厖逄旁舽嫎徬螃鳑龎龐嗙耪覫炐肨胖抛拋脬刨咆垉庖狍炰爮袍匏軳鞄麃麅跑奅泡" +
+		"炮疱皰砲麭礟礮萢褜呸怌肧柸胚衃醅阫陪培毰赔锫裴裵賠駍俖伂沛佩帔姵斾旆浿珮配笩辔馷" +
+		"嶏霈轡蓜喷噴歕瓫盆湓葐呠翸喯匉怦抨恲砰梈烹硑軯閛漰嘭澎磞芃朋挷竼倗莑堋弸彭棚椖塳" +
+		"硼稝蓬鹏槰樥熢憉輣篣膨錋韸髼蟚蟛鬅纄韼鵬騯鬔鑝捧淎皏剻掽椪碰踫篷丕伓伾批纰邳坯披" +
+		"抷炋狉砒悂秛秠紕铍旇翍耚豾鈈鈚鈹鉟銔劈磇駓髬噼錍魾鮍憵礔礕霹皮阰芘岯枇毞狓肶毗毘" +
+		"疲蚍郫陴啤埤崥蚽蚾豼焷琵脾腗鲏罴膍蜱魮壀篺螷貔鵧羆朇鼙匹庀疋仳圮苉脴痞銢諀鴄擗噽" +
+		"癖嚭屁淠渒揊釽媲嫓睥辟潎稫僻澼嚊甓疈譬闢鷿鸊榌囨偏媥犏篇翩鍂鶣骈胼腁楄楩賆跰諚骿" +
+		"蹁駢騈覑谝貵諞片骗騗騙魸剽慓缥飘旚翲螵犥飃飄魒嫖瓢竂薸闝殍彯瞟篻縹醥皫顠票僄勡嘌" +
+		"徱漂氕撇撆暼瞥丿苤鐅嫳姘拼礗穦馪驞玭贫娦貧琕嫔频頻嬪獱薲嚬矉蠙颦顰品榀牝汖聘乒甹" +
+		"俜娉涄砯聠艵竮頩平评凭呯坪泙苹郱屏帡枰洴玶胓荓瓶屛帲淜萍蚲幈焩甁缾蓱蛢評軿鲆凴慿" +
+		"箳輧憑鮃檘簈蘋岼塀钋坡岥泊颇溌鉕頗鏺婆嘙蔢鄱皤謈櫇叵尀钷笸駊岶炇迫敀昢洦珀烞破砶" +
+		"釙粕蒪魄醗泼桲潑剖娝抔抙捊掊裒箁錇咅哣婄犃廍仆攴扑陠噗撲潽擈鯆匍莆脯菩菐葡蒱蒲僕" +
+		"酺墣獛璞濮瞨穙镤襥纀鏷圤朴圃浦烳普溥谱諩樸氆檏镨譜蹼鐠铺舖舗鋪瀑曝巬巭駇贌",
+	// 'Q'
+	"七迉沏妻柒倛凄栖桤郪娸悽桼淒萋攲期棲欺蛣僛嘁慽榿漆緀慼槭諆諿霋蹊魌鏚鶈亓祁齐圻岐" +
+		"岓忯芪亝其奇斉歧畁祇祈肵俟疧竒剘斊旂耆脐蚑蚔蚚颀埼崎帺掑淇猉畦萁萕跂軝釮骐骑棊棋" +
+		"琦琪祺蛴愭碁碕锜頎鬿旗粸綥綦綨蜝蜞齊璂禥蕲踑錡鲯懠濝藄檱櫀臍騎騏鳍蘄鯕鵸鶀麒纃艩" +
+		"蠐鬐鰭玂麡乞邔企屺岂芑启呇杞玘盀唘豈起啓啔婍啟绮晵棨綮綺諬闙气讫忔気汔迄弃汽矵芞" +
+		"呮泣炁盵咠契砌栔氣訖唭欫夡棄湆湇葺碛摖暣甈碶噐憇器憩磜磧磩罊蟿鼜缼戚渏褄緕螧簯簱" +
+		"籏掐葜拤跒酠圶冾帢恰洽殎硈愘髂鞐千仟阡圱圲奷扦汘芊迁佥岍杄汧瓩茾欦臤钎拪牵粁兛悭" +
+		"蚈谸铅婜孯牽釺掔谦鈆雃僉愆签鉛骞鹐慳搴撁箞諐遷褰謙顅檶攐攑櫏簽鵮孅攓騫鬝鬜籤韆仱" +
+		"岒忴扲拑前钤歬虔钱钳掮揵軡媊鈐靬鉗墘榩箝銭潛潜羬蕁橬錢黔黚騝濳騚灊鰬凵浅肷淺脥嗛" +
+		"嵰遣槏膁蜸谴缱繾譴欠刋芡俔茜倩悓堑傔嵌棈椠慊皘蒨塹歉綪蔳儙槧篏輤篟壍縴鰜竏鎆鏲籖" +
+		"鑓呛羌戕戗斨枪玱羗猐跄椌溬腔嗆蜣锖嶈戧槍牄瑲羫锵篬錆謒蹌镪蹡鎗鏘丬強强墙嫱蔷樯漒" +
+		"蔃墻嬙廧薔檣牆艢蘠抢羟搶羥墏繈襁繦鏹炝唴熗羻嗴獇悄硗郻嵪跷鄡鄥劁敲毃踍锹墝頝骹墽" +
+		"幧橇燆缲磽鍫鍬繑趬蹺鐰乔侨荍荞桥硚菬喬僑谯嘺嫶憔蕎鞒樵橋癄瞧礄藮趫鐈鞽顦巧釥愀髜" +
+		"俏诮陗峭帩窍殻翘誚髚僺撬撽鞘韒竅翹譙躈槗犞癿聺且切妾怯郄匧窃悏挈洯惬淁笡愜蛪朅箧" +
+		"緁锲篋踥穕藒鍥鯜鐑竊苆倿媫籡亲侵钦衾骎媇嵚欽綅誛嶔親顉駸鮼寴庈芩芹埁珡秦耹菦蚙捦" +
+		"菳琴琹禽鈙雂勤嗪嫀溱靲慬噙擒斳鳹懄檎澿瘽螓懃蠄鬵鵭坅昑笉梫赾寑锓寝寢鋟螼吢吣抋沁" +
+		"唚菣揿搇撳瀙藽狅靑青氢轻倾卿郬圊埥寈氫淸清傾蜻輕鲭鑋夝甠剠勍情殑晴棾氰葝暒擏樈擎" +
+		"檠黥苘顷请庼頃廎漀請檾庆凊掅殸碃箐靘慶磘磬罄謦硘櫦芎匔卭邛宆穷穹茕桏笻筇赹惸焪焭" +
+		"琼舼蛩蛬煢睘跫銎瞏窮儝憌橩璚藑瓊竆藭瓗熍丘丠邱坵恘秋秌蚯媝萩楸蓲鹙篍緧蝵穐趥鳅蟗" +
+		"鞦鞧鰌鰍鶖蠤龝叴囚扏犰玌汓肍求虬泅虯俅觓訄訅酋釓唒浗紌莍逎逑釚梂殏毬球赇崷巯渞湭" +
+		"皳盚遒煪絿蛷裘巰觩賕璆蝤銶醔鮂鼽鯄鰽搝糗釻蘒区曲伹佉匤岖诎阹驱坥屈岨岴抾浀祛胠袪" +
+		"區紶蛆躯筁粬蛐詘趋嶇憈駆敺誳镼駈麹髷魼趨麯覰軀麴黢覻驅鰸鱋佢劬斪朐胊菃鸲淭渠絇翑" +
+		"葋軥蕖璖磲螶鴝璩蟝瞿鼩蘧忂灈戵欋氍籧臞癯蠷衢躣蠼鑺鸜取竘娶詓竬蝺龋齲厺去刞呿唟耝" +
+		"阒觑趣閴麮闃覷鼁迲衐峑弮恮悛圈圏棬駩鐉全权佺诠姾泉洤荃拳牷辁啳埢婘惓痊硂铨湶犈筌" +
+		"絟葲搼瑔觠詮跧輇蜷銓権踡縓醛鳈鬈騡孉巏鰁權齤蠸颧顴犬汱畎烇绻綣虇劝券牶勧韏勸犭椦" +
+		"楾闎缺蒛阙瘸却卻埆崅寉悫琷雀硞确阕塙搉皵碏愨榷墧慤確碻趞燩闋礐闕灍礭鹊鵲夋囷峮逡" +
+		"宭帬裙羣群裠",
+	// 'R'
+	"呥肰衻袇蚦袡蚺然髥嘫髯燃繎冄冉姌苒染珃媣橪蒅穣儴勷瀼獽蘘禳瓤穰躟鬤壌嚷壤攘爙纕让" +
+		"懹譲讓娆荛饶桡嬈蕘橈襓饒扰隢擾绕遶繞惹热熱人亻仁壬忈朲忎秂芢鈓魜銋鵀忍荏栠栣荵秹" +
+		"棯稔刃刄认仞仭讱任屻岃扨纫妊杒牣纴肕轫韧饪姙祍紉衽紝訒軔梕袵軠絍腍葚靭靱韌飪認餁" +
+		"綛躵扔仍辸礽陾芿日驲囸釰鈤馹茸戎肜栄狨绒茙荣容毧烿媶嵘搑絨羢嫆嵤搈榵溶蓉榕榮熔瑢" +
+		"穁縙蝾褣镕融螎駥髶嬫嶸爃鎔巆瀜曧蠑冗宂坈傇軵氄鴧穃厹禸柔媃揉渘葇煣瑈糅蝚蹂輮鍒鞣" +
+		"瓇騥鰇鶔粈楺韖肉宍腬邚如侞帤茹桇袽铷渪筎蒘銣蕠蝡儒鴑嚅嬬孺濡薷鴽曘燸襦蠕颥醹顬鱬" +
+		"汝肗乳辱鄏擩入洳嗕媷溽缛蓐褥縟扖込杁鳰嶿挼堧撋壖阮朊软耎偄軟媆瑌碝緛輭瓀礝婑桵甤" +
+		"緌蕤蕊蕋橤繠蘂蘃汭芮枘蚋锐瑞蜹睿銳鋭叡壡瞤闰润閏閠潤橍膶捼叒若偌弱鄀渃焫楉蒻箬篛" +
+		"爇鰙鰯鶸嵶",
+	// 'S'
+	"仨挱挲撒洒訯靸潵灑躠卅泧飒脎萨鈒摋馺颯薩櫒虄隡毢愢揌塞毸腮噻鳃顋鰓嗮赛僿賽簺嘥三" +
+		"弎叁毵毿犙鬖仐伞傘糁糂馓糝糣糤繖鏒鏾霰饊俕帴悷散閐壭毶厁橵桒桑嗓搡磉褬颡鎟顙丧喪" +
+		"槡掻慅搔溞骚缫繅臊鳋騒騷鰠鱢扫掃嫂埽瘙氉矂髞螦閪色洓栜涩啬铯雭歮琗嗇瑟歰銫澁懎擌" +
+		"濇瘷穑澀璱瀒穡繬轖鏼譅飋渋濏穯森椮槮襂僧鬙杀沙纱乷刹剎砂唦殺猀粆紗莎桬毮铩痧硰煞" +
+		"蔱裟榝樧魦鲨鎩鯊鯋傻儍倽唼啑啥帹萐厦喢廈歃翜箑翣閯霎繌筛酾篩簁簛釃繺晒閷曬山彡邖" +
+		"删刪杉芟姍姗苫衫钐埏挻柵狦珊舢痁脠軕笘跚剼搧嘇幓煽潸澘檆縿膻鯅羴羶闪陕陝閃晱煔睒" +
+		"熌覢讪汕疝剡扇訕赸掞釤傓善銏骟僐鄯墠墡潬缮嬗擅樿歚膳磰謆赡繕蟮蟺譱贍鐥饍騸鳝灗鱓" +
+		"鱔圸杣閊敾伤殇商觞傷墒慯滳漡蔏殤熵螪觴謪鬺垧扄晌赏賞贘鑜丄上尙尚恦绱緔鞝仩裳弰捎" +
+		"烧莦梢焼稍旓筲艄蛸輎燒颵髾鮹勺芍苕柖玿竰韶少劭卲邵绍哨娋袑紹睄綤潲蕱奢猞赊畬畲輋" +
+		"賒賖檨舌佘虵蛇蛥舍捨厍设社厙射涉涻渉設赦弽慑摂摄滠慴摵蔎歙蠂韘騇懾攝灄麝欇舎申屾" +
+		"扟伸身侁呻妽籶绅诜姺柛氠珅穼籸娠峷甡眒砷莘敒深紳兟棽葠裑訷蓡詵甧蔘燊薓駪鲹曑鵢鯵" +
+		"鰺什甚神邥弞审矤哂矧宷谂谉婶渖訠審諗頣魫曋頥瞫嬸瀋覾讅肾侺昚胂涁眘渗祳脤腎愼慎椹" +
+		"瘆罧蜃蜄滲鋠瘮堔榊鰰升生阩呏声斘昇泩狌苼栍殅牲珄陞陹笙湦焺甥鉎聲鼪鵿绳憴繩譝省眚" +
+		"偗渻圣胜晠剰盛剩勝貹嵊琞聖墭榺蕂賸竔曻橳尸失师呞虱诗邿鸤屍施浉狮師絁釶湤湿葹鈟溮" +
+		"溼獅蒒蓍詩鉇鉈瑡鳲蝨鳾褷鲺濕鍦鯴鰤鶳襹十饣石辻乭时实実旹飠姼峕炻祏蚀食埘時莳寔湜" +
+		"遈塒溡蒔鉐實榯蝕鲥鼫鼭鰣史矢乨豕使始驶兘宩屎笶鉂駛士氏礻丗世仕市示似卋式忕亊叓戺" +
+		"事侍势呩柹视试饰冟室恀恃拭是昰枾柿眂贳适栻烒眎眡舐轼逝铈視豉釈媞崼弑徥揓谥貰释勢" +
+		"嗜弒睗筮觢試軾鈰鉃飾舓誓適鉽奭銴餙餝噬嬕澨諟諡遾螫謚簭襫釋佦竍识拾匙嵵榁煶篒鮖籂" +
+		"識鰘収收手守垨首艏寿受狩兽售授涭绶痩壽夀瘦綬獸鏉扌獣书殳尗抒纾叔杸枢陎姝倏倐書殊" +
+		"紓掓梳淑焂菽軗鄃疎疏舒摅毹綀输瑹跾踈樞蔬輸橾鮛儵攄鵨秫婌孰赎塾熟璹贖鼡属暑暏黍署" +
+		"蜀鼠潻薥薯曙癙藷襡襩屬钃朮术戍束沭述侸凁咰怷树竖荗恕捒庶庻絉蒁術隃尌裋数竪腧鉥墅" +
+		"漱潄數澍豎樹濖錰鏣鶐虪瀭糬蠴鱪鱰刷唰耍誜衰摔甩帅帥蟀卛闩拴閂栓涮腨双霜雙孀骦孇騻" +
+		"欆礵鷞鹴艭驦鸘爽塽慡漺樉縔灀鏯谁脽誰水帨涗涚祱稅税裞睡瞓氵氺閖吮顺舜順蕣橓瞚瞬鬊" +
+		"说哾說説妁烁朔铄欶硕矟搠蒴槊獡碩箾鎙爍鑠厶纟丝司糹私咝泀思虒鸶媤斯絲缌蛳楒禗鉰飔" +
+		"凘厮榹禠罳蜤锶嘶噝廝撕澌磃緦蕬鋖燍螄蟖蟴颸騦鐁鷥鼶籭死巳亖四寺汜佀兕姒泤祀価孠杫" +
+		"泗饲驷娰柶牭洍涘肂飤笥耜釲竢覗嗣肆貄鈶鈻飼禩駟蕼儩瀃俬恖銯忪松枀娀柗倯凇崧庺梥淞" +
+		"菘嵩硹蜙憽濍檧鍶鬆怂悚耸竦傱愯楤嵷慫聳駷讼宋诵送颂訟頌誦餸枩鎹捜鄋嗖廀廋搜溲獀蒐" +
+		"蓃馊摉飕摗锼艘螋醙鎪餿颼颾騪叜叟傁嗾瞍擞薮擻藪櫢籔膄瘶嗽苏甦酥稣窣穌蘇蘓櫯囌俗玊" +
+		"夙泝肃洬涑珟素莤速宿梀殐粛骕傃粟谡嗉塐塑嫊愫溯溸肅遡鹔僳愬榡膆蔌觫趚遬憟樎樕潥碿" +
+		"鋉餗潚縤橚璛簌藗謖蹜驌鱐鷫诉訴鯂狻痠酸匴祘笇筭蒜算夊攵芕虽倠哸浽荽荾眭葰滖睢綏熣" +
+		"濉鞖雖绥隋随遀隨瓍瀡膸髄髓亗岁砕祟谇埣嵗遂歲歳煫睟碎隧嬘澻穂誶賥檖燧璲禭檅穗穟繀" +
+		"襚邃旞繐繸譢鐆鐩韢孙狲荪孫飧搎猻蓀飱槂蕵薞损笋隼筍損榫箰簨鎨鶽唆娑莏傞桫梭睃嗍羧" +
+		"蓑摍缩趖簑簔縮髿鮻所乺唢索琐惢锁嗩暛溑瑣褨璅鎈鎍鎖鎻鏁逤溹蜶琑嗦",
+	// 'T'
+	"他它她牠祂趿铊塌榙溻褟嚃闧蹹塔溚墖獭鳎獺鰨亣拓挞狧闼崉涾搨跶遝遢榻毾禢撻澾誻踏橽" +
+		"錔濌蹋鞜鮙闒鞳嚺闥譶躢侤咜囼孡胎冭台旲邰坮抬苔枱炱炲菭跆鲐箈臺颱駘儓鮐嬯擡薹檯籉" +
+		"太夳忲汰态肽钛泰舦酞鈦溙態燤粏坍抩贪怹痑舑貪摊滩瘫擹攤灘癱坛昙倓谈郯婒惔覃榃痰锬" +
+		"谭墰墵憛潭談醈壇曇燂錟餤檀磹顃罈藫壜譚貚醰譠罎忐坦袒钽菼毯鉭嗿憳憻醓璮襢叹炭埮探" +
+		"傝湠僋嘆碳舕歎賧汤坣铴湯嘡耥劏羰蝪薚镗蹚鏜鐋鞺鼞饧唐堂傏啺棠鄌塘搪溏蓎隚榶漟煻瑭" +
+		"禟膅樘磄糃膛橖篖糖螗踼糛螳赯醣餳鎕餹闛饄鶶伖帑倘偒淌傥躺镋鎲儻戃曭爣矘钂烫摥趟燙" +
+		"夲弢涛绦掏絛詜嫍幍慆搯滔槄瑫韬飸縚縧濤謟轁鞱韜饕匋迯咷洮逃桃陶啕梼淘绹萄祹裪綯蜪" +
+		"鞀醄鞉鋾錭駣檮饀騊鼗讨討套忑忒特貣蚮铽慝鋱螣蟘熥膯鼟疼痋幐腾誊漛滕邆縢駦謄儯藤騰" +
+		"籐鰧籘驣霯虅剔梯锑踢擿鷈鷉苐厗荑绨偍啼崹惿提稊缇罤遆鹈嗁瑅綈碮褆徲漽緹蕛蝭銻题趧" +
+		"蹄醍謕蹏鍗鳀鴺題鮷鵜騠鯷鶗鶙禵鷤体挮躰骵鮧軆體戻迏剃朑洟倜悌涕逖悐惕掦逷惖揥替楴" +
+		"裼褅歒殢髰薙嚏鬀嚔瓋籊趯屉屜笹嵜天兲婖添酟靔黇靝田屇沺恬畋畑盷胋畠甛甜菾湉塡填搷" +
+		"鈿阗緂磌窴璳闐鷆鷏忝殄倎唺悿淟晪琠腆觍痶睓舔餂覥賟錪鍩靦掭睼舚碵鴫旫佻庣恌挑祧聎" +
+		"芀条岧岹迢祒條笤萔蓚蓨趒龆樤蜩鋚鞗髫鲦鯈鎥齠鰷宨晀朓脁窕誂斢窱嬥眺粜絩覜跳糶螩帖" +
+		"怗贴萜聑貼铁蛈僣銕鋨鴩鐡鐵驖呫飻餮厅庁汀艼听町耓厛烃桯烴綎鞓聴聼廰聽廳邒廷亭庭莛" +
+		"停婷嵉渟筳葶蜓楟榳閮霆聤蝏諪鼮圢甼侹娗挺涏梃烶珽脡艇颋誔頲囲炵通痌嗵蓪仝同佟彤峂" +
+		"庝哃峝狪茼晍桐浵烔砼蚒眮秱铜童粡筩詷赨酮鉖僮勭鉵銅餇鲖潼獞曈朣橦氃燑犝膧瞳鮦统捅" +
+		"桶筒統綂樋恸痛衕慟憅偷偸婾媮鋀鍮亠头投骰緰頭妵钭紏敨飳黈蘣透綉凸宊禿秃怢突唋涋捸" +
+		"堗湥痜葖嶀鋵鵚鼵図图凃峹庩徒悇捈荼途屠梌菟揬稌圕塗嵞瘏筡腯蒤鈯圖圗廜潳跿酴馟鍎駼" +
+		"鵌鶟鷋鷵土圡吐钍釷兎迌兔堍鵵汢涂莵湍猯煓貒团団抟剸團慱摶漙槫篿檲鏄糰鷒鷻疃彖湪褖" +
+		"推蓷藬弚颓隤尵頹頺頽魋穨蘈蹪俀腿僓蹆骽侻退娧煺蛻蜕褪駾吞呑涒啍朜焞噋暾黗屯坉忳芚" +
+		"饨豘豚軘飩鲀魨霕臀臋氽畽旽乇仛讬托扡汑饦杔侂咃拕拖沰挩捝莌袥託涶脫脱飥魠驝驮佗陀" +
+		"陁坨岮沱沲狏迱砣砤袉鸵紽堶跎酡碢馱槖駄駞橐鮀鴕鼧騨鼍驒鼉彵妥庹媠椭楕嫷橢鵎鬌鰖柝" +
+		"毤唾萚跅毻箨蘀籜驼駝",
+	// 'U'
+	"",
+	// 'V'
+	"",
+	// 'W'
+	"穵劸挖洼娲畖窊媧嗗蛙搲溛漥窪鼃攨娃瓦佤邷咓袜聉嗢腽膃襪韈韤屲瓲哇歪喎竵崴外夞顡弯" +
+		"剜婠帵塆湾蜿潫豌彎壪灣丸刓汍纨芄完岏抏玩紈捖顽烷琓頑翫宛倇唍挽盌埦婉惋晚梚绾脘菀" +
+		"萖晩晼椀琬皖畹睕碗綩綰輓踠鋄鋔万卍卐妧忨捥脕貦萬腕輐澫薍錽蟃贃鎫贎邜杤笂尣尪尫汪" +
+		"尩亡亾兦王仼彺莣蚟罒网往徃罔徍惘菵暀棢蛧辋網蝄誷輞瀇魍妄忘迋旺盳望朢枉焹危威烓偎" +
+		"萎逶隇隈喴媙愄揋揻渨葨葳微椳楲溦煨詴蜲蝛覣薇燰鳂巍鰃鰄囗韦圩围帏沩违闱峗峞洈韋桅" +
+		"涠唯帷惟硙维喡圍媁嵬幃湋溈琟違潍維蓶鄬潙潿磑醀濰鍏闈鮠癓覹犩霺欈厃伟伪尾纬芛苇委" +
+		"炜玮洧娓屗浘荱诿偉偽崣梶痏硊骩嵔徫愇猥葦蒍骪骫暐椲煒瑋痿腲艉韪僞撱磈鲔寪緯蔿諉踓" +
+		"韑頠薳儰濻鍡鮪壝瀢韙颹韡蘤斖卫为未位味苿為畏胃叞軎尉菋谓喂媦渭爲煟碨蔚蜼慰熭犚緭" +
+		"衛懀璏罻衞謂餧鮇螱褽餵魏藯轊鏏霨鳚蘶饖讆躗讏躛捤煀猬墛縅蝟嶶昷塭温榅殟溫瑥辒瘟蕰" +
+		"豱輼轀鳁鞰鰛鰮匁文彣纹芠炆玟闻紋蚉蚊珳阌琝雯瘒聞馼魰鳼鴍螡閺閿蟁闅鼤闦刎吻忟抆呡" +
+		"肳紊桽脗稳穏穩问妏汶莬問渂揾搵顐璺呚鈫鎾翁嗡滃鹟螉鎓鶲勜奣塕嵡蓊暡瞈聬瓮蕹甕罋齆" +
+		"挝倭涡莴唩涹渦猧萵窝窩蜗撾蝸踒我婐捰仴沃肟卧枂臥偓捾涴媉幄握渥焥硪楃腛斡瞃擭濣瓁" +
+		"臒雘龌齷乌圬弙汙汚污邬呜巫杇屋洿诬钨烏剭窏鄔嗚歍誣箼螐鴮鎢鰞无毋吳吴吾呉芜郚唔娪" +
+		"洖浯茣莁梧珸祦無铻鹀禑蜈誈蕪璑蟱鯃鵐譕鼯鷡五午仵妩庑忤怃旿武玝侮俉倵捂啎娬牾珷摀" +
+		"碔鹉熓瑦舞嫵廡憮潕儛橆甒鵡躌兀勿戊阢伆屼扤坞岉杌芴迕忢物矹卼敄误悞悟悮粅逜晤焐婺" +
+		"嵍痦隖靰骛塢奦嵨溩雺雾寤熃誤鹜遻鋈窹霚鼿霧齀蘁騖鶩乄务伍務錻",
+	// 'X'
+	"夕兮吸忚扱汐覀希扸卥昔析穸肸肹俙徆怸恓郗饻唏奚屖悕氥浠牺狶莃唽悉惜捿晞桸欷淅烯焁" +
+		"焈琋硒菥赥釸傒惁晰晳焟焬犀睎稀粞翕舾鄎厀嵠徯溪皙蒠锡僖榽煕熄熈熙緆蜥豨餏嘻噏嬆嬉" +
+		"嶲潝瘜磎膝凞憙樨橀熹熺熻窸縘羲螅螇錫燨瞦蟋谿豀豯貕糦繥雟鵗觹譆醯鏭隵巇曦爔犧酅觽" +
+		"鼷蠵鸂觿鑴习郋席習袭觋媳椺蒵蓆嶍漝覡趘槢薂隰檄謵鎴霫鳛飁騱騽襲鰼驨枲洗玺徙铣喜葈" +
+		"葸鈢鉨鉩屣漇蓰憘暿歖禧諰壐縰謑蟢蹝璽囍鱚矖躧匸卌戏屃系饩呬忥怬矽细係咥恄盻郤欯绤" +
+		"細釳阋喺椞翖舃舄趇隙慀滊禊綌赩隟墍熂犔稧潟澙蕮覤戱黖戲磶虩餼鬩繫嚱闟霼屭衋西息渓" +
+		"橲犠礂鯑虲疨虾谺傄閕煆煵颬瞎蝦鰕匣侠狎俠峡柙炠狭陜峽烚狹珨祫硖翈舺陿硤遐敮暇瑕筪" +
+		"舝碬辖磍縀蕸縖赮魻轄鍜霞鎋黠騢鶷閜丅下乤吓疜夏睱嚇懗罅鎼夓鏬圷梺溊仚屳先奾纤佡忺" +
+		"氙杴祆秈苮枮籼珗莶掀訮铦跹酰锨僊嘕銛鲜暹韯嬐憸薟鍁褼韱鮮蹮馦廯攕纎鶱襳躚纖鱻伭闲" +
+		"妶弦贤咸唌挦涎胘娴娹婱絃舷蚿衔啣痫蛝閑閒鹇嫌衘甉銜嫺嫻憪撏澖稴誸賢燅諴輱醎癇癎瞯" +
+		"藖礥鹹麙贒鷳鷴鷼冼狝显险崄毨烍猃蚬険赻筅尟尠搟禒跣銑箲險嶮獫獮藓鍌燹顕幰攇櫶蘚譣" +
+		"玁韅顯灦伣县咞岘苋现线臽限姭宪県陥哯垷娊娨峴涀莧陷晛現硍馅睍絤缐羡献粯羨腺蜆僩僴" +
+		"綫誢撊線鋧憲橌縣錎餡壏豏麲瀗臔獻糮鼸仙僲繊鑦乡芗相香郷厢啌鄉鄊廂湘缃葙鄕稥薌箱緗" +
+		"膷襄忀骧麘欀瓖镶鑲驤瓨佭详庠栙祥絴翔詳跭享亯响饷晑飨想銄餉鲞曏蠁鮝鯗響饗饟鱶向姠" +
+		"巷蚃项珦象塂缿萫衖項像勨嶑銗橡襐嚮蟓闀鐌鱌楿鱜灱灲呺枭侾哓枵骁哮宯宵庨消绡虓逍鸮" +
+		"婋梟焇猇萧痚痟硝硣窙翛萷销揱綃嘋嘐歊潇箫踃嘵憢獢銷霄彇膮蕭魈鴞穘簘藃蟂蟏鴵嚣瀟簫" +
+		"蟰髇櫹嚻囂髐蠨驍毊虈洨笅郩崤淆訤殽筊誵小晓暁筱筿皛曉篠謏皢孝肖効咲俲效校涍笑啸傚" +
+		"敩詨嘨誟嘯歗熽鞩斅斆恷滧些揳猲楔歇蝎蠍劦协旪邪協胁垥奊峫恊拹挟挾脅脇衺偕斜谐翓嗋" +
+		"愶携瑎綊熁膎勰撷擕緳缬蝢鞋頡諧燲擷鞵襭攜纈讗龤写冩寫藛伳灺泄泻祄绁缷卸洩炧卨娎屑" +
+		"屓偞偰徢械烲焎禼紲亵媟屟渫絏絬谢僁塮榍榭褉噧屧暬緤嶰廨懈澥獬糏薢薤邂韰燮褻謝駴瀉" +
+		"鞢瀣爕繲蟹蠏齘齛齥齂躞脋夑心邤妡忻芯辛昕杺欣炘盺俽惞訢鈊锌新歆廞鋅嬜薪馨鑫馫枔襑" +
+		"鐔伈阠伩囟孞信軐脪衅訫焮煡馸顖舋釁忄噺星垶骍惺猩煋瑆腥蛵觪箵篂鮏曐觲鍟騂皨鯹刑行" +
+		"邢形陉侀郉型洐荥钘陘娙硎铏鈃滎鉶銒鋞睲醒擤兴杏姓幸性荇倖莕婞悻涬緈興嬹臖哘裄謃凶" +
+		"兄兇匈讻忷汹哅恟洶胷胸訩詾賯雄熊焽诇焸詗夐敻休俢修咻庥烋烌羞脩脙鸺臹貅馐樇銝髤髹" +
+		"鎀鵂鏅饈鱃飍苬朽滫綇糔秀岫峀珛绣袖琇锈嗅溴璓褎褏銹螑繍繡鏥鏽齅鮴吁戌旴疞盱欨胥须" +
+		"晇訏顼虗虚谞媭幁揟湑虛裇須楈窢頊嘘墟需魆噓嬃歔縃蕦蝑諝譃繻魖驉鑐鬚俆徐蒣许呴姁诩" +
+		"冔栩珝偦許暊詡稰鄦糈醑盨旭伵序汿芧侐卹怴沀叙恤昫洫垿欰殈烅珬勖敍敘勗烼绪续酗喣壻" +
+		"婿朂溆絮訹慉煦蓄賉槒漵潊盢瞁緒聟銊獝稸緖魣藇瞲藚續鱮聓続蓿吅轩昍宣弲軒梋谖喧塇媗" +
+		"愃愋揎萱萲暄煊瑄蓒睻儇禤箮縇翧蝖鋗懁蕿諠諼鍹駽矎翾藼蘐蠉譞玄玹痃悬旋琁蜁嫙漩暶璇" +
+		"檈璿懸咺选晅烜選顈癣癬怰泫昡炫绚眩袨铉琄眴衒渲絢楥楦鉉碹蔙镟鞙颴縼繏鏇讂贙鰚削疶" +
+		"蒆靴薛辥辪鞾穴斈乴学岤峃茓泶袕鸴踅壆學嶨澩燢觷雤鷽雪鳕鱈血吷坹狘桖谑趐謔瀥膤樰艝" +
+		"轌坃勋埙焄勛塤熏窨蔒勲勳薫駨壎獯薰曛燻臐矄蘍壦纁醺廵寻旬巡驯杊畃询峋恂洵浔紃荀荨" +
+		"栒桪毥珣偱尋循揗槆潃詢馴鄩鲟噚潯攳樳燖璕蟳鱏鱘灥卂讯伨汛迅侚巺徇狥迿逊殉訊訙奞巽" +
+		"殾稄遜愻賐噀潠蕈鵕爋顨鑂训訓嚑",
+	// 'Y'
+	"丫圧压吖庘押枒垭鸦桠鸭埡孲椏鴉錏鴨壓鵶鐚牙伢厑岈芽厓玡琊笌蚜堐崕崖涯猚瑘睚衙漄齖" +
+		"厊庌哑唖啞痖雅瘂蕥劜圠轧亚襾讶亜犽迓亞軋娅挜砑俹氩婭掗訝铔揠氬猰聐圔稏窫齾乛呀恹" +
+		"剦烟珚胭偣啱崦淊淹焉焑菸阉湮猒腌煙硽鄢嫣漹醃閹嬮懨篶懕臙黫讠延严妍芫言岩昖沿炎郔" +
+		"姸娫狿研莚娮盐琂硏閆阎嵒嵓湺筵綖蜒塩揅楌詽碞蔅颜厳虤閻檐顏顔嚴壛巌簷櫩黬壧孍巗巖" +
+		"礹鹽麣夵抁沇乵兖奄俨兗匽弇衍偃厣掩眼萒郾酓嵃愝扊揜棪渰渷琰遃隒椼罨裺演褗嶖戭蝘魇" +
+		"噞躽縯檿験黡厴甗鰋鶠黤齞龑儼黭顩鼴巘巚曮魘鼹齴黶厌闫妟觃牪咽姲彥彦砚唁宴晏烻艳覎" +
+		"验偐焔谚隁喭堰敥焰焱硯葕雁傿椻溎滟鳫厭墕暥酽嬊谳餍鴈燄燕諺赝鬳曕鴳酀騐嚥嬿艶贋曣" +
+		"爓醶騴鷃灔贗觾讌醼饜驗鷰艷灎釅驠灧讞豓豔灩訁熖樮軅欕央咉姎抰泱殃胦眏秧鸯鉠雵鞅鴦" +
+		"扬羊阦阳旸杨炀飏佯劷氜疡钖垟徉昜洋羏烊珜眻陽崵崸揚蛘敭暘楊煬禓瘍諹輰鍚鴹颺鐊鰑霷" +
+		"鸉仰佒坱岟养柍炴氧痒紻傟楧軮慃氱蝆養駚懩攁癢怏恙样羕詇様漾樣瀁奍羪礢幺夭吆妖枖殀" +
+		"祅訞喓葽楆腰鴁邀爻尧尭肴垚姚峣轺倄烑珧窑傜堯揺谣軺嗂媱徭愮搖摇猺遙遥暚榣瑤瑶銚飖" +
+		"餆嶢嶤窯窰餚繇謠謡鎐鳐颻蘨邎顤鰩仸宎岆抭杳狕苭咬柼眑窅窈舀偠婹崾溔蓔榚鴢鼼闄騕齩" +
+		"鷕穾药要钥袎窔筄葯詏熎覞靿獟鹞薬曜燿艞藥矅耀纅鷂讑鑰倻掖椰暍噎潱蠮耶捓揶铘釾鋣鎁" +
+		"擨也吔冶埜野嘢漜壄业叶曳页曵邺夜抴亱枼頁晔枽烨啘液谒堨殗腋葉鄓墷楪業馌僷曄曅歋燁" +
+		"擛皣瞱鄴靥嶪嶫澲謁餣嚈擫曗瞸鍱擪爗礏鎑饁鵺鐷靨驜鸈爷亪爺一乊弌伊衣医吚壱依祎咿洢" +
+		"悘猗郼铱壹揖欹蛜禕嫛漪稦銥嬄噫夁瑿鹥繄檹毉醫黟譩鷖黳乁仪匜圯夷迆冝宐沂诒侇怡沶狋" +
+		"衪迤饴咦姨峓恞拸柂珆瓵贻迻宧巸弬扅栘桋眙胰袘訑貤痍移耛萓凒羠蛦詑詒貽遗媐暆椸誃跠" +
+		"頉颐飴疑儀熪箷遺嶬彛彜螔頤寲嶷簃顊彝彞謻鏔觺讉鸃乙已以钇佁攺矣肔苡苢庡舣蚁釔倚扆" +
+		"笖逘酏偯崺旑椅鉯鳦裿旖踦輢敼螘檥礒艤蟻顗轙齮乂义亿弋刈忆艺肊议亦伇屹异芅伿佚劮呓" +
+		"坄役抑杙耴苅译邑佾呭呹峄怈怿易枍欥泆炈秇绎诣驿俋奕帟帠弈枻洂浂玴疫羿衵轶唈垼悒挹" +
+		"捙栧栺欭浥浳益袣谊陭勚埶埸悥掜殹異硛羛翊翌訲訳豙豛逸釴隿幆敡晹棭殔湙焲蛡詍跇軼鈠" +
+		"骮亄兿意溢獈痬睪竩缢義肄裔裛詣勩嫕廙榏潩瘗膉蓺蜴靾駅億撎槸毅熠熤熼瘞誼镒鹝鹢黓劓" +
+		"圛墿嬑嬟嶧憶懌曀殪澺燚瘱瞖穓縊艗薏螠褹寱斁曎檍歝燡燱翳翼臆賹鮨癔藙藝贀鎰镱繶繹豷" +
+		"霬鯣鶂鶃瀷蘙譯議醳醷饐囈鐿鷁鷊懿襼驛鷧虉鷾讛齸辷匇衤宜畩萟椬鶍籎囙因阥阴侌垔姻洇" +
+		"茵荫音骃栶殷氤陰凐秵裀铟陻隂喑堙婣愔筃絪歅溵禋蔭慇摿瘖銦緸鞇諲霒駰噾闉霠韾冘乑吟" +
+		"犾苂斦烎垠泿圁峾狺珢荶訔訚婬寅崟崯淫訡银鈝龂滛碒鄞夤蔩銀噖殥璌誾嚚檭蟫霪齗鷣乚廴" +
+		"尹引吲饮蚓赺隐淾鈏飲隠靷飮朄輑磤趛檃瘾隱嶾濥濦螾蘟櫽癮讔印茚洕胤垽堷湚猌廕蒑酳慭" +
+		"癊憖憗鮣懚檼粌应応英偀桜莺啨婴媖渶绬朠煐瑛嫈碤锳嘤撄甇緓缨罂蝧賏樱璎罃褮鍈霙鴬鹦" +
+		"嬰應膺韺甖鹰鶑鶧嚶孆孾攖罌蘡譍櫻瓔礯譻鶯鑍纓蠳鷪鷹鸎鸚盁迎茔盈荧莹営萤营萦蛍溁溋" +
+		"萾僌塋楹滢蓥潆熒瑩蝿嬴營縈螢濙濚濴藀覮謍赢瀅鎣攍瀛瀠瀯櫿瀴贏籝籯矨郢浧梬颍颕颖摬" +
+		"影潁璄瘿穎頴巊廮癭映暎硬媵膡噟鞕鐛鱦珱愥蝇縄攚蠅灐灜軈哟唷喲佣拥痈邕庸傭嗈鄘雍墉" +
+		"嫞慵滽槦噰壅擁澭郺镛臃癕雝鏞鳙廱灉饔鱅鷛癰喁揘牅颙顒鰫永甬咏泳俑勇勈栐埇悀柡涌恿" +
+		"傛惥愑湧硧詠塎嵱彮愹蛹慂踊禜鲬踴鯒用苚醟怺砽优忧攸呦怮泑幽逌悠麀滺憂優鄾嚘瀀櫌纋" +
+		"耰尢尤由沋犹邮油肬怣斿疣峳浟秞莜莸郵铀偤蚰訧逰游猶遊鱿楢猷鈾鲉輏駀蕕蝣魷輶鮋櫾有" +
+		"丣卣苃酉羑庮栯羐莠梄聈脜铕湵禉蜏銪槱牖黝懮又右幼佑侑狖糿哊囿姷宥峟柚牰祐诱迶唀蚴" +
+		"亴貁釉酭誘鼬友孧蒏牗扜纡迂迃穻陓紆虶唹淤盓毺瘀箊亐于邘伃余妤扵杅欤玗玙於盂臾衧鱼" +
+		"乻俞兪禺竽舁茰娛娯娱桙狳谀酑馀渔萸隅雩魚堣堬崳嵎嵛愉揄楰渝湡畭硢腴萮逾骬愚旕楡榆" +
+		"歈牏瑜艅虞觎漁睮窬舆褕歶羭蕍蝓諛雓餘嬩澞覦踰歟璵螸輿鍝謣髃鮽旟籅騟蘛鰅鷠鸆与予伛" +
+		"宇屿羽雨俁俣禹语圄峿祤偊匬圉庾敔鄅斞萭傴寙楀瑀瘐與語窳鋙頨龉噳嶼懙貐斔麌蘌齬肀玉" +
+		"驭圫聿芋芌妪忬饫育郁昱狱秗茟俼峪彧浴砡钰预喐域堉悆惐欲淢淯谕逳阈喅喩喻媀寓庽御棛" +
+		"棜棫焴琙矞硲裕遇飫馭鹆愈滪煜稢罭艈蒮蓣誉鈺預嫗嶎戫毓獄瘉緎蜟蜮輍銉噊慾潏稶蓹薁豫" +
+		"遹鋊鳿澦燏燠蕷諭錥閾鴥鴪儥礇禦魊鹬癒礖礜穥篽繘醧鵒櫲饇譽轝鐭霱欎驈鬻籞鱊鷸鸒欝龥" +
+		"軉鬰鬱灪籲爩挧荢澚鯲囦鸢剈冤悁眢鸳寃渁渆渊渕惌淵葾棩蒬蜎裷鹓箢鳶蜵駌鴛嬽鵷灁鼘鼝" +
+		"元円贠邧员园沅杬垣爰貟原員圆笎蚖袁厡圎援湲猨缘茒鼋園圓塬媴嫄源溒猿獂蒝榞榬辕緣縁" +
+		"蝝蝯魭橼羱薗螈謜轅黿鎱櫞邍騵鶢鶰厵远盶逺遠鋺夗肙妴苑怨院垸衏傆媛掾瑗禐愿裫褑褤噮" +
+		"願酛鈨曰曱约約箹矱彟彠月戉刖妜岄抈礿岳玥恱悅悦蚎蚏軏钺阅捳跀跃粤越鈅粵鉞閱閲嬳樾" +
+		"篗嶽龠籆瀹蘥黦爚禴躍籥鸑籰鸙晕缊蒀暈氲煴蒕氳奫蝹縕赟頵馧贇云勻匀囩妘沄纭芸昀畇眃" +
+		"秐郧涢紜耘耺鄖雲愪溳筠筼蒷榲熉澐蕓鋆橒篔縜饂允阭夽抎狁陨荺殒喗鈗隕殞褞馻磒賱霣齳" +
+		"孕运枟郓恽鄆酝傊惲愠運慍腪韫韵熅熨緷緼蕴薀醖醞餫藴韗韞蘊韻抣繧",
+	// 'Z'
+	"帀匝沞迊咂拶紥紮鉔魳臜臢杂砸偺喒韴雑嶻磼襍雜囋囐雥咋災灾甾哉栽烖菑渽睵賳宰崽再在" +
+		"扗侢洅载傤載酨儎縡兂糌簪簮鐕鐟咱昝沯桚寁揝噆撍儧攅攒儹攢趱礸趲暂暫賛赞錾鄼濽蹔瓉" +
+		"贊鏨瓒酇灒讃瓚禶襸讚饡匨牂羘赃賍臧蔵賘贓髒贜驵駔奘弉脏塟葬銺臓臟傮遭糟蹧醩凿鑿早" +
+		"枣蚤棗澡璪薻繰藻灶皁皂唕唣造梍喿慥艁噪簉燥竃譟趮躁竈栆则択沢择泎泽责迮則荝唶啧帻" +
+		"笮舴責溭矠嘖嫧幘箦樍諎赜擇澤皟瞔簀礋襗謮賾蠌齚齰鸅夨仄庂汄昃昗捑崱伬蔶贼戝賊鲗鯽" +
+		"蠈鰂鱡怎谮譖譛囎増鄫增憎缯橧熷璔矰磳罾繒譄锃鋥甑赠贈鱛扎吒抯奓挓柤査哳偧喳揸渣楂" +
+		"劄摣皶樝觰皻譇齄齇札甴闸蚻铡煠牐閘箚耫鍘譗厏拃苲眨砟搩鲊鲝踷鮓鮺乍灹诈咤柞栅炸宱" +
+		"痄蚱溠詐搾榨霅醡捚斋斎摘榸齋宅檡窄鉙债砦債寨瘵夈粂沾毡旃栴粘蛅飦惉詀趈詹閚谵噡嶦" +
+		"薝邅霑氈氊瞻鹯旜譫饘鳣驙魙鱣鸇讝斩飐展盏崭斬椫琖搌盞嶃嶄榐颭嫸醆橏輾黵占佔战栈桟" +
+		"站偡绽菚棧湛戦綻嶘輚戰虥虦覱轏譧蘸驏张張章傽鄣墇嫜彰慞漳獐粻蔁遧暲樟璋餦蟑騿鱆麞" +
+		"仉长長涨掌漲礃丈仗扙帐杖胀账帳涱脹痮障嶂幛賬瘬瘴瞕粀幥鏱鐣佋钊妱巶招昭盄釗啁鉊駋" +
+		"窼鍣皽爪找沼瑵召兆诏枛垗炤狣赵笊肁旐棹詔照罩肇肈趙曌燳鮡櫂瞾羄爫罀蜇嗻嫬遮厇折歽" +
+		"矺砓籷虴哲埑粍袩啠悊晢晣辄喆蛰詟谪馲摺輒磔輙銸辙蟄嚞謫謺鮿轍讁讋者乽啫禇锗赭褶襵" +
+		"这柘浙這淛樜潪鹧蟅鷓着著蔗贞针侦浈珍珎胗貞帪栕桢眞真砧祯針偵桭酙寊葴遉嫃搸斟楨獉" +
+		"甄禎蒖蓁鉁靕榛殝瑧碪禛潧箴樼澵臻薽錱轃鍼籈鱵诊抮枕弫昣轸屒畛疹眕袗紾聄裖診軫絼缜" +
+		"稹駗縥鬒黰圳阵纼甽侲挋陣鸩振朕栚紖眹赈酖塦揕敶瑱誫賑镇震鴆鎭鎮萙鋴争佂姃征怔爭诤" +
+		"埩峥挣炡狰烝眐钲崝崢掙猙睁聇铮媜揁筝徰蒸睜踭鉦徴箏錚徵篜鬇鯖癥氶抍糽拯掟晸愸撜整" +
+		"正证郑帧政症幀証塣諍鄭鴊證凧之支卮汁芝吱巵汥坧枝泜知织肢栀祗秓秖胑胝衼倁疷祬秪脂" +
+		"隻梔戠椥臸搘禔稙綕榰蜘馶鳷鴲鵄織蘵鼅执侄妷直姪値值聀釞埴執淔职貭植殖犆禃絷褁跖嗭" +
+		"瓡鉄墌摭馽嬂慹漐踯樴膱儨縶職蟙蹠軄躑夂止只劧旨阯址坁帋扺汦沚纸芷怾抧祉咫恉指枳洔" +
+		"砋衹轵淽疻紙訨趾軹黹酯藢襧阤至芖志忮扻豸制厔垁帙帜治炙质迣郅峙庢庤挃柣栉洷祑陟娡" +
+		"徏挚晊桎狾秩致袟贽轾乿偫徝掷梽楖猘畤痔秲秷窒紩翐袠觗铚鸷傂崻彘智滞痣蛭軽骘寘廌搱" +
+		"滍稚筫置跱輊锧雉墆滯潌疐製覟誌銍幟憄摯熫稺膣觯質踬鋕擳旘瀄緻駤鴙劕懥擲櫛穉螲懫贄" +
+		"櫍瓆觶騭鯯礩豑騺驇躓鷙鑕豒凪俧徔謢中伀汷刣妐彸忠泈炂终柊盅衳钟舯衷終鈡幒蔠锺銿螤" +
+		"螽鍾鼨蹱鐘籦肿种冢喠尰塚塜歱煄腫瘇種踵穜仲众妕狆祌茽衶重蚛偅眾堹媑筗衆諥迚州舟诌" +
+		"侜周洲诪烐珘辀郮徟掫淍矪週鸼喌粥赒輈銂賙輖霌盩謅鵃騆譸妯轴軸肘疛菷晭睭箒鯞纣伷呪" +
+		"咒宙绉冑咮昼紂胄荮皱酎晝粙葤詋甃詶僽皺駎噣縐骤籀籕籒驟帚炿駲朱劯侏诛邾洙茱株珠诸" +
+		"猪硃秼袾铢絑蛛誅跦槠潴蝫銖橥諸豬駯鮢鴸瀦櫫櫧鯺鼄蠩竹泏竺炢笁茿烛窋逐笜舳瘃築燭蠋" +
+		"躅鱁孎灟曯欘爥蠾丶主宔拄罜陼渚煮煑詝嘱濐麈瞩劚囑斸矚伫佇住助纻苎坾杼注贮迬驻壴柱" +
+		"殶炷祝疰眝砫祩竚莇紵紸羜蛀嵀筑註貯跓軴铸筯鉒馵箸翥樦鋳駐篫霔麆鑄墸抓檛膼簻髽拽跩" +
+		"专叀専砖專鄟塼嫥瑼甎磗膞颛磚諯蟤顓鱄转孨転竱轉灷啭堟蒃瑑腞僎赚撰篆馔篹襈賺譔饌囀" +
+		"籑妆庄妝荘娤桩莊梉湷粧装裝樁糚壮壯状狀壵焋漴撞戇庒隹追骓锥錐騅鵻沝坠桘笍娷惴甀缒" +
+		"畷硾膇墜赘縋諈醊錣餟礈贅譵轛鑆缀綴宒迍肫窀谆諄衠准埻準綧訰稕凖卓拙炪倬捉桌棁涿棳" +
+		"穛穱蠿圴彴汋犳灼叕妰茁斫浊丵浞烵诼酌啄啅娺梲斱晫椓琸硺窡罬撯擆斲槕禚諁諑鋜濁篧擢" +
+		"斀斵濯櫡謶镯鐯鵫灂蠗鐲籗鷟籱劅窧乲孜茊兹咨姕姿茲栥玆紎赀资淄秶缁谘嗞孳嵫椔湽滋粢" +
+		"葘辎鄑孶禌觜訾貲資趑锱稵緇鈭镃龇輜鼒澬諮趦輺錙髭鲻鍿鎡璾頿頾鯔鶅齍鰦蓻仔吇姉姊杍" +
+		"矷秄胏呰秭籽耔虸笫梓釨啙紫滓訿榟字自芓茡倳剚恣牸渍眥眦胔胾漬子崰橴宗倧综骔堫嵏嵕" +
+		"惾棕猣腙葼朡椶嵸稯綜緃熧緵翪蝬踨踪磫鍐豵蹤騌鬃騣鬉鬷鯮鯼鑁总偬捴惣愡揔搃傯蓗摠総" +
+		"縂總鏓纵昮疭倊猔碂粽糉瘲縦錝縱糭潈邹驺诹郰陬菆棷棸鄒箃緅諏鄹鲰鯫黀騶齱齺赱走奏揍" +
+		"楱鯐租葅蒩卆足卒哫崒崪族傶箤踤踿镞鏃诅阻组俎爼珇祖組詛靻鎺钻躜鑽繤缵纂纉籫纘攥鑚" +
+		"厜朘嗺樶蟕纗嶊嘴嶵噿璻栬絊酔最晬祽稡罪辠槜酻蕞醉檇鋷錊檌枠穝尊墫壿嶟遵樽繜罇鐏鳟" +
+		"鱒鷷僔噂撙譐捘銌鶎昨秨莋捽椊琢稓筰鈼左佐唨繓作坐阼岝岞怍侳祚胙唑座袏做葃葄飵糳咗" +
+		"蓙",
+}
+
+// strokeGroups holds the characters in stroke order, grouped by their
+// number of strokes minus one.
+var strokeGroups = [...]string{
+	// 1 stroke
+	"一丨丶丿乀乁⺄乙乚乛𠃊𠃋𠃌𠃍𠃑亅𠄌〆〇〡〥〻",
+	// 2 strokes
+	"丁丂七丄丅丆丩丷乂乃乄𠂆𠂇𠂊乜九了𠄎二亠人亻儿入八⺆冂冖冫⺇几凵⺈刀刁刂力勹匕匚" +
+		"匸十⺊卜卩厂厶⺀又巜讠⻏⻖𨸏〢〤〦",
+	// 3 strokes
+	"万丈三上下丌亐卄㐄个丫丸义久乆乇么乊乞也习亇亍于亏亡亼亽亾亿兀兦凡凢凣刃刄劜勺卂" +
+		"千㔾卪卫叉口囗土士夂夊夕大夨女子孑孒孓宀寸⺌⺍小尢𡯁𡯂尸屮山巛川𡿨工己已巳巾干乡" +
+		"幺广廴廾弋弓⺕彐彑彡彳忄扌才氵犭纟⺾艹⻌门阝飞饣马々〣〧",
+	// 4 strokes
+	"不与丏丐丑丒专中丮丯丰丹为之乌尹乣乤乥书予云互亓五井亖亢亣什仁仂仃仄仅仆仇仈仉今" +
+		"介仌仍从仏仐仑仒仓允兂元內公六兮兯冃冄内円冇冈㓁冗冘凤𠘰凶𠙶刅分切刈劝办勻勼勽勾" +
+		"勿匀匁匂㔫化匹区㔹卅卆升午卝卞卬厃厄厅历厷厸厹及友双反収圠圡𡈼壬夃天太夫夬夭孔尐" +
+		"少尣尤尺屯乢屲巴巿帀币幻廿开弌弔引弖心忆戈戶户戸手扎𢩦支攴攵文斗斤方无旡⺜日曰⺝" +
+		"月木朩𣎴欠止歹殳毋毌比毛氏气水火灬⺥爪爫父爻丬爿片㸦牙⺧牛牜犬王𤣩礻𥘅罓耂肀⺼见" +
+		"计订讣认讥贝车⻍辶闩韦风〨〩",
+	// 5 strokes
+	"丗㐀且丕世丘丙业丛东丝丱主丼乍乎乏乐𠂔乧亗㐰㐱㐲㐳㐴㐵㐶㐷仔仕他仗付仙仚仛仜仝仞" +
+		"仟仠仡仢代令以仦仧仨仩仪仫们仭𠆩𠆫兄充㒰兰冉冊冋册𠕇写冚冬冭冮冯凥処凧凷凸凹出击" +
+		"刉刊刋刌刍功加务劢匃匄包匆匇北匛匜匝匞卉半卌卟占卡卢卭卮卯𠨑厇厈厉厺去厼叏叐发古" +
+		"句另叧叨叩只叫召叭叮可台叱史右叴叵叶号司叹叺叻叼叽叾𠮨𠮩囘囙囚四囜㘦圢圣圤圥圦圧" +
+		"壭处外夗夘央夯夰失夲夳头奴奵奶孕宁宂它宄对尒尓尔尕尻尼屳屴屵屶屷左巧巨㠲㠳市布帄" +
+		"帅平幼庀庁庂広弁弍弗弘归㣺必忇忉忊𢖯戉戊戋戹扐扑扒打扔払扖扏斥旦旧𣄽曱未末本札朮" +
+		"术朰正歺母氐民氕氺氶氷永氹氻氾氿汀汁汃汄汅汇汈汉灭犮犯犰玄玉玊玌玍瓜瓦甘生用甩田" +
+		"由甲申甴电⺪疋𤴓疒癶白皮皿目矛矢石⺬示禸禾穴立纠罒𦉪𦉫肊艺衤𧘇讦讧讨让讪讫讬训议" +
+		"讯记讱轧辷邒邓钅长闪阞队饤饥驭鸟龙",
+	// 6 strokes
+	"㐁丞丟丠両丢乑乒乓乔乨乩乪乫乬乭乮乯买争亘亙亚㐫交亥亦产㐸㐹㐻㐿㑀仮仯仰仱仲仳仴" +
+		"仵件价仸仹仺任仼份仾仿伀企伂伃伄伅伆伇伈伉伊伋伌伍伎伏伐休伒伓伔伕伖众优伙会伛伜" +
+		"伝伞伟传伡伢伣伤伥伦伧伨伩伪伫伬佤𠇁𠇔兆兇先光兊全氽共兲关兴再冎军农冰冱冲决冴𠖳" +
+		"凨凩凪凫凼刎刏刐刑划刓刔刕刖列刘则刚创劣劤劥劦劧动匈匟匠匡匢㔻卋卍华协卐印危㕂厊" +
+		"压厌厍厽厾叒㕦叿吀吁吂吃各吅吆吇合吉吊吋同名后吏吐向吒吓吔吕吖吗𠮿𠯆囝回囟因囡团" +
+		"団在圩圪圫圬圭圮圯地圱圲圳圴圵圶圷圸圹场𡉏壮夅夙多夛夵夶夷夸夹夺夻夼㚥奷奸她奺奻" +
+		"奼好奾奿妀妁如妃妄妅妆妇妈𡚸𡚺孖字存孙𡥄宅宆宇守安寺寻导尖尗尘尥尦尧尽𡰪屰屸屹屺" +
+		"屻屼屽屾屿岀岁岂岃𡵆州巟巩巪㠴㠵㠶帆帇师年幵并庄庅庆廵异弎式弐弙弚弛弜当彴彵忈忋" +
+		"忏忓忔忕忖忙忚忛𢖾戌戍戎戏成扗托扙扚扛扜扝扞扠扡扢扣扤扥扦执扨扩扪扫扬扟攰收攷旨" +
+		"早旪旫旬旭旮旯㬰曲曳有㭁朱朲朳朴朵朶朷朸朹机朻朼朽朾朿杀杁杂权次欢此死毎毕氒氖気" +
+		"氘氼汆汊汋汌汍汎汏汐汑汒汓汔汕汗汘汙汚汛汜汝江池污汢汣汤汷灮灯灰灱灲灳爷牝牞牟犱" +
+		"犲犳犴犵犷犸𤜥㺨㺩㺪玎玏玐玑甪甶百癿⺮礼穵竹米糸糹纡红纣纤纥约级纨纩纪纫缶网⺶羊" +
+		"𦍋𦍌羽老考而耒耳聿𦘒肉肋肌肍肎臣自至臼𦥑舌舛舟艮色艸艻艼艽艾艿芀芁节虍虫血行衣襾" +
+		"西覀观讲讳讴讵讶讷许讹论讻讼讽设访诀贞负贠赱轨辸边辺辻込辽邔邖邗邘邙邚邛邜邝钆钇" +
+		"闫闬闭问闯阠阡阢阣阤页饦饧驮驯驰齐",
+	// 7 strokes
+	"丣两严丽𠀡串𠁨乕乱乲亊𠄘亜亨亩亪㑆伭伮伯估伱伲伳伴伵伶伷伸伹伺伻似伽伾伿佀佁佂佃" +
+		"佄佅但佇佈佉佊佋位低住佐佑佒体佔何佖佗佘余佚佛作佝佞佟你佡佢佣佥佦佧佨𠇲克兌免兎" +
+		"兏児兑㒳兵冏冝㓈况冶冷冸冹冺冻凬㓟刜初刞刟删刡刢刣判別刦刧刨利刪别刬刭助努劫劬劭" +
+		"劮劯劰励劲劳労匉𠣕㔰匣匤匥㔷医卣卤卲即却卵厎厏厐厑县叓㕭㕰㕲吘吙吚君吜吝吞吟吠吡" +
+		"吢吣吤吥否吧吨吩吪含听吭吮启吰吱吲吳吴吵吶吷吸吹吺吻吼吽吾吿呀呁呂呃呄呅呆呇呈呉" +
+		"告呋呌呍呎呏呐呑呒呓呔呕呖呗员呙呚呛呜𠯋𠯢𠯫𠯻𠯿囤囥囦囧囨囩囪囫囬园囮囯困囱囲図" +
+		"围囵㘩㘫㘭㘮㘰圻圼圽圾圿址坁坂坃坄坅坆均坈坉坊坋坌坍坎坏坐坑坒坓坔坕坖块坘坙坚坛" +
+		"坜坝坞坟坠𡉼壯声壱売壳夆夋夽夾夿奀奁奂㚪㚬妉妊妋妌妎妏妐妑妒妓妔妕妖妗妘妙妚妛妜" +
+		"妝妞妟妠妡妢妣妤妥妦妧妨妩妪妫𡛀𡛁𡛂𡛓𡛕孚孛孜孝孞宊宋完宍宎宏宐宑宒寽対寿尨尩尪" +
+		"尫尬尾尿局屁层屃岄岅岆岇岈岉岊岋岌岎岏岐岑岒岓岔岕岖岗岘岙岚岛岜岍巠巫巵㠷㠸㠹㠻" +
+		"帉帊帋希帍帎帏帐庇庈庉床庋庌庍庎序庐庑庒库应廷弃弄弅弝弞弟张𢎽形彣彤彶彷彸役彺彻" +
+		"㤀忌忍忎忐忑忒志忘応㤈忟忡忣忤忦忧忨忪快忬忭忮忯忰忱忲忳忴忶忷忸忹忺忻忼忾怀怃怄" +
+		"怅怆我戒戓𢦓戺戻戼㧑扭扮扯扰扱扲扳扴扵扶扷批扺扻扼扽找技抁抂抃抄抅抆抇抈抉把抋抌" +
+		"抍抎抏抐抑抒抓抔投抖抗折抙抚抛抜抝択抟抠抡抢抣护报扸攸改攺攻攼𢻯斈斘旰旱旲旳旴旵" +
+		"时旷旸更曵㭂㭃㭄㭅㭆杄杅杆杇杈杉杊杋杌杍李杏材村杒杓杔杕杖杗杘杙杚杛杜杝杞束杠条" +
+		"杢杣杤来杦杧杨杩极𣏌欤㱐步歼𣧂每毐𣫮毜毝氙氚求汖汞汥汦汧汨汩汪汫汭汮汯汰汱汲汳汴" +
+		"汵汶汸汹決汻汼汽汾汿沁沂沃沄沅沆沇沈沉沋沌沍沎沏沐沑沒沔沕沖沘沙沚沛沜沞沟沠没沢" +
+		"沣沤沥沦沧沨沩沪𣲙𣲚𣲛㶥灴灵灶灷灸灹灺灻灼災灾灿炀牠牡牢牣牤𤘘状犹犺犻犼犽犾犿狁" +
+		"狂狃狄狅狆狇狈𤜯㺭玒玓玔玕玖玗玘玙玚玛𤣰𤣱𤣲𤣳瓧甫甬㽕男甸甹町甼疓疔疕疖疗皀皁皂" +
+		"皃盀盁盯矣矴矵矶𥐙礽䄦禿秀私秂秃究穷竌竍糺系纶纬纭纮纯纰纱纲纳纴纵纷纸纹纺纻纼纽" +
+		"纾罕耴肐肑肒肓肔肕肖肗肘肙肚肛肜肝肞肟肠臫良芃芄芅芆芇芈芉芊芋芌芍芎芏芐芑芒芓芕" +
+		"芖芗𦬅𦬊虬𧘌見觃⻆角𧢲言訁证诂诃评诅识诇诈诉诊诋诌词诎诏诐译诒谷豆豕豸貝贡财赤走" +
+		"⻊足身車轩轪轫辛辰辵巡达辿迀迁迂迃迄迅迆过迈迉𨑨𨑬𨑳邑邞邟邠邡邢那邤邥邦邧邨邩邪" +
+		"邬𨚪𨚫酉釆里针钉钊钋钌闰闱闲闳间闵闶闷阥阦阧阨阩阪阫阬阭阮阯阰阱防阳阴阵阶𨸶𨸹韧" +
+		"飏饨饩饪饫饬饭饮驱驲驳驴鸠鸡麦龟",
+	// 8 strokes
+	"並丧丳乖乳乴乵乶乷乸𠃮事些亝亞亟㐭享京佌㑌㑐佩佪佫佬佭佮佯佰佱佲佳佴併佶佷佸佹佺" +
+		"佻佼佽佾使侀侁侂侃侄侅來侇侈侉侊例侌侍侎侏侐侑侒侓侔侕侖侗侘侙侚供侜依侞侟侠価侢" +
+		"侣侤侥侦侧侨侩侪侫侬侭𠈄𠈌𠈔兒兓兔兕兖𠒇兩其具典冐冞冼冽冾冿净𠗃凭凮凯函㓤刮刯到" +
+		"刱刲刳刴刵制刷券刹刺刻刼刽刾刿剀剁剂𠜎剆㔚劵劶劷劸効劺劻劼劽劾势勆匊匋匌𠤖匦匼卑" +
+		"卒卓協单卖卥卦卧𠧧卶卷卸卹卺厒厓厔厕𠩐叀叁参叔叕取受变㕷㕸呝呞呟呠呡呢呣呤呥呦呧" +
+		"周呩呪呫呬呭呮呯呱味呴呵呶呷呸呹呺呻呼命呾呿咀咁咂咃咄咅咆咇咈咉咊咋和咍咎咏咐咑" +
+		"咒咓咔咕咖咗咘咙咚咛咜咝𠰋𠰍𠰠𠰴𠰺𠰻𠱁𠱂𠱃㘠囶囷囸囹固囻囼国图㘱㘲㘳㘴㘵坡坢坣坤" +
+		"坥坦坧坨坩坪坫坬坭坮坯坰坱坲坳坴坵坶坷坸坹坺坻坼坽坾坿垀垁垂垃垄垅垆垇垈垉垊𡊨𡊩" +
+		"𡊰备夌夜夝奃奄奅奆奇奈奉奋奌奍𡘊奔㚰㚱㚵㚹㚼㛁妬妭妮妯妰妱妲妳妴妵妶妷妸妹妺妻妼" +
+		"妽妾妿姀姁姂姃姄姅姆姇姈姉姊始姌姍姎姏姐姑姒姓委姖姗𡛟𡛦𡛧𡛨𡛺𡛻𡛼𡛾㝀孟孠孡孢季" +
+		"孤孥学孧𡥘宓宔宕宖宗官宙定宛宜宝实実宠审𡧛尀尙尚尭屄居屆屇屈屉届㞹㞾岝岞岟岠岡岢" +
+		"岣岤岥岦岧岨岩岪岫岬岭岮岯岰岱岲岳岴岵岶岷岸岹岺岻岼岽岾岿峀峁峂峃峄峅𡶐㠰巶帑帒" +
+		"帓帔帕帖帗帘帙帚帛帜𢁾幷幸庘底庖店庙庚府庝庞废延㢠廸廹弆弡弢弣弤弥弦弧弨弩弪𢏐彔" +
+		"录㣌𢒋彼彽彾彿往征徂徃径忝忞忠忢忥忩念忽忿态怂㤔怇怈怉怊怋怌怍怏怐怑怓怔怕怖怗怙" +
+		"怚怛怜怞怟怡怢怦性怩怪怫怬怭怮怯怰怲怳怴怵怶怺怽怾怿𢘛𢘜𢘫戔戕或戗戽戾房所承㧔㧕" +
+		"㧙㧚㧜㧝㧞㧟抦抧抨抩抪披抬抭抮抯抰抱抲抳抴抵抶抷抸抹抺抻押抽抾抿拀拁拂拃拄担拆拇" +
+		"拈拉拊拋拌拍拎拐拑拒拓拔拕拖拗拘拙拚招拝拞拟拠拡拢拣拤拥拦拧拨择𢫏𢫕㪁攽放斉𣁄斦" +
+		"斧斨斩斺斻於𣃚旹旺旻旼旽旾旿昀昁昂昃昄昅昆昇昈昉昊昋昌昍明昏昐昑昒易昔昕昖昗昘昙" +
+		"曶㬳朊朋朌服㭇㭈㭉㭊㭋㭌㭍㭎㭏㭐杪杫杬杭杮杯杰東杲杳杴杵杶杷杸杹杺杻杼杽松板枀枂" +
+		"枃构枅枆枇枈枉枊枋枌枍枎枏析枑枒枓枔枕枖林枘枙枚枛果枝枞枟枠枡枢枣枤枥枦枧枨枩枪" +
+		"枫枬枭𣏞𣏦𣏴𣏵𣏹𣏺𣏾𣐀柹㰠欣欥欦欧武歧歨歩歽歾歿殀殁殴毑毞毟氓氛氜氝汬沀沊沓沝㳋" +
+		"㳍㳑沫沬沭沮沰沱沲河沴沵沶沷沸油沺治沼沽沾沿泀況泂泃泄泅泆泇泈泊泋泌泍泎泏泐泑泒" +
+		"泓泔法泖泗泘泙泛泜泝泞泟泠泡波泣泤泥泦泧注泩泪泫泬泭泮泯泱泲泳泷泸泹泺泻泼泽泾𣲵" +
+		"𣲷𣳇𣳈𣳉洰炇炁炂炃炄炅炆炈炉炊炋炌炍炎炏炐炑炒炓炔炕炖炗炘炙炚炛炜炝炞𤆣𤆤𤆥𤆬𤆵" +
+		"爬爭爸牀版㸯牥牦牧牨物牪牫牬𤘪狀㹢㹩狉狋狌狍狎狏狐狑狒狓狔狕狖狗狘狙狚狛狜狝狞玜" +
+		"玝玞玟玠玡玢玣玤玥玦玧玨玩玪玫玬玭玮环现玱𤣻𤣿𤤀𤤁𤤌瓝瓨瓩甙画甽甾甿畀畁畂畃畄畅" +
+		"疌疘疙疚疛疜疝疞疟疠疡癷的皯盂盰盱盲盳直盵矤知矷矸矹矺矻矼矽矾矿砀码𥐥社礿祀祁祂" +
+		"祃秄秅秆秇秈秉秊䆒穸穹空穻䇄竎竏竺竻籴籵籶䊵糼糽糾糿线绀绁绂练组绅细织终绉绊绋绌" +
+		"绍绎经绐缷罔罖罗罙羋羌者耓耵肃肏䏙股肢肣肤肥肦肧肨肩肪肫肬肭肮肯肰肱育肳肴肵肶肷" +
+		"肸肹肺肻肼肽肾肿胀胁臤臥臽臾舍舎舏舠艰芘芙芚芛芜芝芞芟芠芡芢芣芤芥芦芧芨芩芪芫芬" +
+		"芭芮芯芰花芲芳芴芵芶芷芸芹芺芼芽芾苀苁苂苃苄苅苆苇苈苉苊苋苌苍苎苏茾𦬓𦬕𦬨芿虎虏" +
+		"虭虮虯虰虱虲𧗠补表规觅诓诔试诖诗诘诙诚诛诜话诞诟诠诡询诣诤该详诧诨诩豖责贤败账货" +
+		"质贩贪贫贬购贮贯軋转轭轮软轰迊迋迌迍迎迏运近迒迓返迕迖迗还这迚进远违连迟迬﨤𨒂邭" +
+		"邮邯邰邱邲邳邴邵邶邷邸邹邺邻𨚼采金釒钍钎钏钐钑钒钓钔钕钖钗長镸門闸闹阜阷阸阹阺阻" +
+		"阼阽阾阿陀陁陂陃附际陆陇陈陉隶隹⻗雨靑青非靣顶顷饯饰饱饲饳饴驵驶驷驸驹驺驻驼驽驾" +
+		"驿骀鱼鸢鸣鸤黾鼡齿",
+	// 9 strokes
+	"临举乗㐠乹乺乻乼亭亮亯亰亱亲侮侯侰侱侲侳侴侵侶侷侸侹侺侻侼侽侾便俀俁係促俄俅俆俇" +
+		"俈俉俊俋俌俍俎俏俐俑俒俓俔俕俖俗俘俙俚俛俜保俞俟俠信俢俣俤俥俦俧俨俩俪俫俬俭𠉛兗" +
+		"兘兙𠒎兪兹养冑冒冟冠凁凂凃𠗊𠗐𠗕凾剃剄剅則剈剉削剋剌前剎剏剐剑勀勁勂勃勄勅勇勈勉" +
+		"勊勋匍匧匨匩匽南単卻卼卽厖厗厘厙厚厛叙叚叛叜叝呰呲㖄咞咟咠咡咢咣咤咥咦咧咨咩咪咫" +
+		"咬咭咮咯咰咱咲咳咴咵咶咷咸咹咺咻咼咽咾咿哀品哂哃哄哅哆哇哈哉哊哋哌响哎哏哐哑哒哓" +
+		"哔哕哖哗哘哙哚哛哜哝哞哟𠱓𠱥𠱷𠱸𠱼𠲍𠲖𠲜㘢囿圀𡇙㘶㘷㘸㘹㘻㘾型垌垍垎垏垐垑垒垓垔" +
+		"垕垖垗垘垙垚垛垜垝垞垟垠垡垢垣垤垥垦垧垨垩垪垫垬垭垮垯垰垱垲垳垴垵城𡋣壴壵夈変复" +
+		"㚚奎奏奐契奒奓奕奖𡘓𡘙姕㛃㛄㛅㛇㛈妍姘姙姚姛姜姝姞姟姠姡姢姣姤姥姦姧姨姩姪姫姭姮" +
+		"姯姰姱姲姳姴姵姶姷姸姹姺姻姼姽姾姿娀威娂娃娅娆娇娈𡜍𡜐𡜦娍孨孩孪客宣室宥宦宨宩宪" +
+		"宫𡧳封専将尛尜尝尮尯屋屌屍屎屏峆峇峈峉峊峋峌峍峎峏峐峑峒峓峔峕峖峗峘峙峚峛峜峝峞" +
+		"峟峠峡峢峣峤峥峦峧𡶶𡶺𡷊𡷑峸巬巭巷巸巹巺巻帝帞帟帠帡帢帣帤帥带帧𢂚幽庛庠庡庢庣庤" +
+		"庥度𢈈庰建廻廼𢌡𢌥弇弈弫弬弭弮弯𢏗彖彥彦待徆徇很徉徊律後徍徔怎怒怘思怠怣怤急怨怱" +
+		"怷怸怹总怼㤢㤦㤧㤭恀恂恃恄恅恆恇恈恉恊恌恍恎恑恒恓恔恗恘恛恜恞恟恠恡恢恤恦恨恪恫" +
+		"恬恮恰恱恲恸恹恺恻恼恽𢙨战扁扂扃㧘拏拜㧡㧢㧥㧦拪拫括拭拮拯拰拱拴拵拶拷拸拹拺拻拼" +
+		"拽拾挀持挂挃挄挅挆指按挊挋挌挍挎挏挑挒挓挔挕挖挗挘挜挝挞挟挠挡挢挣挤挥挦挧𢫦𢫨𢬎" +
+		"𢬢攱政㪃㪄㪅敀敁敂敃敄故㪼㪽斪斫㫆施斾斿旀既㫞㫠昚昛昜昝昞星映昡昢昣昤春昦昧昨昩" +
+		"昪昫昬昭昮是昰昱昲昳昴昵昶昷昸昹昺昻昼昽显昿𣅽𣆂曷朎朏朐朑㭑㭒㭓㭔㭕㭖㭗枮枯枰枱" +
+		"枲枳枴枵架枷枸枹枺枻枼枾枿柀柁柂柃柄柅柆柇柈柉柊柋柌柍柎柏某柑柒染柔柕柖柗柘柙柚" +
+		"柛柜柝柞柟柠柢柣柤查柦柧柨柩柪柫柬柭柮柯柰柱柲柳柵柶柷柸柺査柼柽柾柿栀栁栂栃栄栅" +
+		"栆标栈栉栊栋栌栍栎栏栐树桒㰦欨欩欪㱔歪歫殂殃殄殅殆殇段殶毒㲋毖毗毘毠毡𣭚氞氟氠氡" +
+		"氢沗沯泉泴泶㳖㳜泚泿洀洁洂洃洄洅洆洇洈洉洊洋洌洎洏洐洑洒洓洔洕洗洘洙洚洛洝洞洟洠" +
+		"洡洢洣洤津洦洧洨洩洪洫洬洭洮洱洲洳洴洵洶洷洸洹洺活洼洽派洿浀流浂浃浄浅浇浈浉浊测" +
+		"浌浍济浏浐浑浒浓浔浕𣳼𣳽𣳾𣳿㶭炟炠炡炢炣炤炥炦炧炨炩炪炫炬炭炮炯炰炱炲炳炴炵炶炷" +
+		"炸点為炻炼炽炾炿烀烁烂烃𤇍𤇢爮爯爰𤔅爼牁牉牊牭牮牯牰牱牲牳牴牵狊狟狠狡狢狣狤狥狦" +
+		"狧狨狩狪狫独狭狮狯狰狱狲𤞏玅㺱玲玳玴玵玶玷玸玹玻玽玾玿珀珁珂珃珄珅珆珇珈珉珊珋珌" +
+		"珍珎珏珐珑𤤖𤤗𤤙𤤯𤤳𤤴瓪瓫瓬瓭瓮瓯瓰瓱瓲甚甠甭甮㽘㽙畆畇畈畉畊畋界畍畎畏畐畑畒畓" +
+		"㽼疢疣疤疥疦疧疨疩疪疫疬疭疮疯疺癸癹発皅皆皇皈盃盄盅盆盇盈䀝盶盷相盹盺盻盼盽盾盿" +
+		"眀省眂眃眄眅眆眇眈眉眊看県眍𥄫矜矦矧矨䂚泵砂砃砄砅砆砇砈砉砊砋砌砍砎砏砐砑砒砓研" +
+		"砕砖砗砘砙砚砛砜𥐯𥐰䄀祄祅祆祇祈祉祊祋祌祍祎视𥘵禹禺䄲秋秌种秎秏秐科秒秓秔秕秖秗" +
+		"𥝲䆕穼穽穾穿窀突窂窃𥥆竐竑竒竓竔竕竖竗竼竽竾竿笀笁笂笃𥫩䉺娄籷籸籹籺类籼籽籾籿粀" +
+		"粁粂䊶䊷䊹紀紁紂紃約紅紆紇紈紉绑绒结绔绕绖绗绘给绚绛络绝绞统䍂缸罘罚羍美羏羑𦍑羾" +
+		"羿𦏵𦏸耇耍耎耏耐耑耔耶耷䏟胂胃胄胅胆胇胈胉胊胋背胍胎胏胐胑胒胓胕胖胗胘胙胚胛胜胝" +
+		"胞胟胠胡胢胣胤胥胦胧胨胩胪胫脉致臿舡舢舣舤芔苐苑苒苓苔苕苖苗苘苙苚苛苜苝苞苟苠苡" +
+		"苢苣苤若苦苧苨苩苪苫苬苭苮苯苰英苲苳苴苵苶苷苸苹苺苻苼苽苾苿茀茁茂范茄茅茆茇茉茊" +
+		"茋茌茍茎茏茐茑茓茔茕𦭐𦭑𦭒𦭓𦭛茺虐虳虴虵虶虷虸虹虺虻虼虽虾虿蚀蚁蚂蚃䘏衁衂衍衎𧗤" +
+		"衦衧衩衪衫衬𧘲𧘹要覌觇览觉觓觔訂訃訄訅訆訇計诪诫诬语诮误诰诱诲诳说诵诶貞貟負贰贱" +
+		"贲贳贴贵贶贷贸费贺贻赲赳赴赵趴軌軍轱轲轳轴轵轶轷轸轹轺轻迠迡迢迣迤迥迦迧迨迩迪迫" +
+		"迭迮迯述迱迲迳邼邽邾邿郀郁郂郃郄郅郆郇郈郉郊郋郍郎郏郐郑郓郕𨛘𨛦郱酊酋重釓釔钘钙" +
+		"钚钛钜钝钞钟钠钡钢钣钤钥钦钧钨钩钪钫钬钭钮钯閁閂闺闻闼闽闾闿阀阁阂陊陋陌降陎陏限" +
+		"陑陒陓陔陕𨹥𨹦面革韋韨韭音頁顸项顺须風飐飑飒飛⻞食飠饵饶饷饸饹饺饻饼首𩠐香骁骂骃" +
+		"骄骅骆骇骈骉⻣鳬鸥鸦鸧鸨鸩",
+	// 10 strokes
+	"𠀾丵乘乽亳㑥㑦修俯俰俱俲俳俴俵俶俷俸俹俺俻俼俽俾俿倀倁倂倃倄倅倆倇倈倉倊個倌倍倎" +
+		"倏倐們倒倓倔倕倖倗倘候倚倛倜倝倞借倠倡倢倣値倥倦倧倨倩倪倫倬倭倮倯倰倱倲倳倴倵倶" +
+		"倷倸倹债倻值倽倾倿𠉴𠉵𠊙𠊞𠊠偖党兛𠒑𠒒兺兼冓冔冡冢冣冤冥冦冧𠖎凄凅准凇凈凉凊凋凌" +
+		"凍凎𠗟𠗠𠙖剒剓剔剕剖剗剘剙剚剛剜剝剞剟剠剡剢剣剤剥剦剧𠜱勌勍勎勏勐勑𠡳匎匪匫卿厜" +
+		"厝厞原虒叞叟㖗㖘哠員哢哣哤哥哦哧哨哩哪哫哬哭哮哯哰哱哲哳哴哵哶哷哸哹哺哻哼哽哾哿" +
+		"唀唁唂唃唄唅唆唇唈唉唊唋唍唎唏唐唑唒唓唔唕唖唗唘唙唚唛唜唝唞唟唠唡唢唣唤唥唦唧𠲵" +
+		"𠲸𠳏𠳓𠳔𠳕𠳖𠳝𠳭𠳿𠴕㘣圁圂圃圄圅圆垶垷垸垹垺垻垼垽垾垿埀埁埂埃埄埅埆埇埈埉埊埋埌" +
+		"埍埏埐埑埒埓埔埕埖埗埘埙埚埛﨏𡋾𡌂𡌃𡌄𡌅堲壶夎夏夞𡖖奊套奘奙奚㛎㛑㛓㛔㛖㛝㛡㛢姬" +
+		"娉娊娋娌娎娏娐娑娒娓娔娕娖娗娘娙娚娛娜娝娞娟娠娡娢娣娤娥娦娧娨娩娪娭娮娯娰娱娲娳" +
+		"娴𡜺𡜻𡜼𡝗㝃孫孬孭𡥪宧宬宭宮宯宰宱宲害宴宵家宷宸容宺宻宼宽宾尃射尅㞗屐屑屒屓屔展" +
+		"屖屗屘𡱰峨峩峪峫峬峭峮峯峰峱峲峳峴峵島峷峹峺峻峼峽峾峿崀崁崂崃崄崅𡷫𡷹㠫差巼帨帩" +
+		"帪師帬席帮帯帰帱𢃇𢇃座庨庩庪庫庬庭庮庯廽弉弰弱弲弳彧彨𢒑徎徏徐徑徒従徕𢓭㤠㤫恁恋" +
+		"恏恐恕恖恙恚恝恣恥恧恩恭息恳恴恵恶恷㤱㤳㤴㤶㤷㤸㤹恾悀悁悂悃悄悅悇悈悋悌悍悎悏悑" +
+		"悒悓悔悕悖悗悙悚悛悜悝悞悟悢悦悧悩悭悮悯𢙺𢚖𢚘戙扄扅扆扇㧬拲拳拿挈挐挙挚挛㧸挨挩" +
+		"挪挫挬挭挮振挰挱挳挴挵挶挷挸挹挺挼挽挾挿捀捁捂捃捄捅捆捇捈捉捊捋捌捍捎捏捐捑捒捓" +
+		"捔捕捖捗捘捙捚捛捜捝捞损捠捡换捣捤𢬿𢭃𢭪揤㪇㪈㪉敆敇效敉敊敋敌𣁋斊斋料斚㫉旁旂旃" +
+		"旄旅旆旊晀晁時晃晄晅晆晇晈晉晊晋晌晍晎晏晐晑晒晓晔晕晖𣆤𣆥晟晠書曺曻㬴㬵朒朓朔朕" +
+		"朗枽柡柴㭘㭙㭚㭛㭜㭝㭞㭟㭠㭡㭢㭣㭤㭥㭦㭧栒栓栔栕栖栗栘栙栚栛栜栝栞栟栠校栢栣栤栥" +
+		"栦栧栨栩株栫栬栭栮栯栰栱栲栳栴栵栶样核根栺栻格栽栾栿桀桁桂桃桄桅框桇案桉桊桋桌桍" +
+		"桎桏桐桑桓桔桕桖桗桘桙桚桛桜桝桞桟桠桡桢档桤桥桦桧桨桩桪𣐿𣑐𣑯𣑲欫欬欭欮欯欰欱欴" +
+		"歬歭㱡殈殉殊残殷毙毢毣毤毥毦毧毨毩毪氣氤氥氦氧氨氩泰洜洯浆㳯洍洖浖浗浘浙浚浛浜浝" +
+		"浞浟浠浡浢浣浤浥浦浧浨浩浪浫浬浭浮浯浰浱浲浳浴浵浶海浸浹浺浻浼浽浾浿涀涁涂涃涄涅" +
+		"涆涇消涉涊涋涌涍涏涐涑涒涓涔涕涖涗涘涚涛涜涝涞涟涠涡涢涣涤涥润涧涨涩𣵀𣵛㶴烄烅烆" +
+		"烇烈烉烊烋烌烍烎烏烐烑烒烓烔烕烖烗烘烙烚烛烜烝烞烟烠烡烢烣烤烥烦烧烨烩烪烫烬热烮" +
+		"𤇼𤈛爱爹牂𤕸㸠牶牷牸特牺㹴狳狴狵狶狷狸狹狺狻狼狽狾猀猁猂猃玆㺸玺玼㺿㻂㻇珒珓珔珕" +
+		"珖珗珘珙珚珛珜珝珞珟珠珡珢珣珤珥珦珧珨珩珪珫珬班珮珯珰珱珲琉𤤾𤤿𤥀𤥁𤥂𤥃珹瓞瓟瓳" +
+		"瓴瓵甡畔畕畖畗畘留畚畛畜畝畞畟疍疰疱疲疳疴疶疷疸疹疻疼疽疾疿痀痁痂痃痄病痆症痈痉" +
+		"畠皊皋皌皍𤽜㿭皰皱䀀盉益盋盌盍盎盏盐监𥁒䀦眎眏眐眑眒眓眔眕眖眗眘眙眚眛眜眝眞真眠" +
+		"眡眢眣眤眧眨眩眪眫眬眿𥅈矝矩䂨砝砞砟砠砡砢砣砤砥砧砨砩砪砫砬砭砮砯砰砱砲砳破砵砶" +
+		"砷砸砹砺砻砼砽砾砿础硁𥑆𥑬𥑮䄃祏祐祑祒祓祔祕祖祗祘祙祚祛祜祝神祟祠祢𥙑𥜽秘秙秚秛" +
+		"秜秝秞租秠秡秢秣秤秥秦秧秨秩秪秫秬秭秮积称窄窅窆窇窈窉窊窋窌窍窎𥥖䇊竘站竚竛竜竝" +
+		"竞䇗䇛笅笆笇笈笉笊笋笌笍笎笏笐笑笒笓笔笕笄粃粄粅粆粇粈粉粊粋粌粍粎粏粐粑䊼紊紋紌" +
+		"納紎紏紐紑紒紓純紕紖紗紘紙級紛紜紝紞紟素紡索紣紤紥紦紧绠绡绢绣绤绥绦继绨䍃缹缺缼" +
+		"罛罜罝罞罟罠罡罢羐羒羓羔羖羗羘羙翀翁翂翃翄翅翆𦐂𦐐𦐑𦐒耄耆耊耕耖耗耘耙耸耹耺耻耼" +
+		"耽耾耿聀聁聂肁肂䏭胭胮胯胰胱胲胳胴胵胶胷胸胹胺胻胼能胿脀脁脂脃脄脅脆脇脈脊脋脌脍" +
+		"脎脏脐脑脒脓𦚯𦚱𦚵臬臭𦤹舀舁舐䑥舥舦舧舨舩航舫般舭舮舯舰舱艳䒟䒠䒢芻茈茖茗茘茙茚" +
+		"茛茜茞茟茠茡茢茤茥茦茧茨茩茪茫茬茭茮茯茰茱茲茳茴茵茶茷茸茹茼茽茿荀荁荂荃荄荅荇荈" +
+		"草荊荋荌荍荎荏荐荑荒荔荕荖荗荘荚荛荜荝荞荟荠荡荢荣荤荥荦荧荨荩荪荬荭荮药𦭵𦮂𦮖𦮗" +
+		"𦮝𦮳荓虑虓虔蚄蚅蚆蚇蚉蚊蚋蚌蚍蚎蚏蚐蚑蚒蚓蚔蚕蚖蚗蚘蚙蚚蚛蚜蚝蚞蚟蚠蚡蚢蚣蚤蚥蚦" +
+		"蚧蚨蚩蚪蚬衃衄䘕衏衭衮衯衰衱衲衳衴衵衶衷衸衹衺衻衼衽衾衿袀袁袂袃袄袅袆袇𧙕𧙖𧙗覍" +
+		"覎觊訉訊訋訌訍討訏訐訑訒訓訔訕訖託記訙訚𧥧请诸诹诺读诼诽课诿谀谁谂调谄谅谆谇谈谉" +
+		"谊谸豇豈豗豹豺豻財貢貣貤𧴯贼贽贾贿赀赁赂赃资赅赆䞘赶起赸䟕趵趶趷趸躬軎軏軐軑軒軓" +
+		"軔軕轼载轾轿辀辁辂较辱迴迵迶迷迸迹迺迻迼追迾迿退送适逃逄逅逆逇逈选逊邕郖郗郘郙郚" +
+		"郛郜郝郞郟郠郡郢郣郤郥郦郧酌配酎酏酐酑酒釕釖釗釘釙釚釛釜針釞釟釠釡釢钰钱钲钳钴钵" +
+		"钶钷钸钹钺钻钼钽钾钿铀铁铂铃铄铅铆铇铈铉铊铋铌铍铎閃閄閅𨳊𨳍阃阄阅阆陖陗陘陙陛陜" +
+		"陝陞陟陠陡院陣除陥陦陧陨险陚𨺗隺隻隼隽难顼顽顾顿颀颁颂颃预飢飣飤饽饾饿馀馁馂馬骊" +
+		"骋验骍骎骏骨高髟鬥鬯鬲鬼鱽鸪鸫鸬鸭鸮鸯鸰鸱鸲鸳鸴鸵鸶龀",
+	// 11 strokes
+	"㐢乾乿亀㑤偀偁偂偃偄偅偆假偈偉偊偋偌偍偎偏偐偑偒偓偔偕偗偘偙做偛停偝偞偟偠偡偢偣" +
+		"偤健偦偧偩偪偫偬偭偮偯偰偱偲偳側偵偶偷偸偹偺偻偼偽偾偿𠊷𠊿𠋀𠋥兜兝兞兽㒼冕冨减凐" +
+		"凑𠗫凰剨剪剫剬剭剮副剰剱剶𠝹㔠勒勓勔動勖勘務勚匏匐匓㔭匘匙㔱匬匭匮匾匿區卙卨卾厠" +
+		"厡厢厣厩參叄唌㖡㖥㖭唨唩唪唫唬唭售唯唰唱唲唳唴唵唶唷唸唹唺唻唼唽唾唿啀啁啂啃啄啅" +
+		"商啇啈啉啊啋啌啍啎問啐啑啒啓啔啕啖啗啘啚啛啜啝啞啠啡啢啣啤啥啦啧啨啩啪啬啭啮啯啰" +
+		"啱啲啳啴啵啶啷啸啹𠴨𠴱𠴲𠵆𠵇𠵈𠵉𠵌𠵍𠵎𠵯𠵱𠵴𠵼𠵾𠵿𠶖𠶜𠶧𠶲啫営圇圈圉圊國圏㙇㙈㙉" +
+		"埜埝埞域埠埡埢埣埤埥埦埧埨埩埫埬埭埮埯埰埱埲埳埴埵埶執埸培基埻埼埽埾埿堀堁堂堃堄" +
+		"堅堆堇堈堉堊堋堌堍堎堏堐堑堒堓堔堕𡌶𡌺埪堵壷壸够夠奛奜奝奞𡘾奟奢娫娽㛥㛦娬娵娶娷" +
+		"娸娹娺娻娼娾娿婀婁婂婃婄婅婆婇婈婉婊婋婌婍婎婏婐婑婒婓婔婕婖婗婘婙婚婛婜婝婞婟婠" +
+		"婡婢婣婤婥婦婧婨婩婪婫婬婭婮婯婰婱婲婳婴婵婶𡝬𡝭𡝮𡝯𡝰𡝱𡝳𡝴媎孮孯孰孲宿寀寁寂寃" +
+		"寄寅密寇寈寉𡨭𡨴將專尉屙屚屛屜屝屠崆崇崈崉崊崋崌崍崎崏崐崑崒崓崔崕崖崗崘崙崚崛崜" +
+		"崝崞崟崠崡崢崣崤崥崦崧崨崩崪崫崬崭崮崯崰𡸜𡸷𡸽巢巣㠱帲帳帴帵帶帷常帹帺帻帼帾庱庲" +
+		"庳庴庵庶康庸庹庺庻庼庾弴張弶強弸弹𢏺彗彩彫彬徖得徘徙徛徜徝從徟徠御徢徣徤𢔓𢔛㤰㤲" +
+		"㤵㤻恿悆悉悊悐悘悠悡患悤悥您悪悫悬㤿㥍悰悱悴悵悷悸悺悻悼悽悾悿惀惂惃情惆惇惈惊惋" +
+		"惍惏惐惓惔惕惗惘惙惚惛惜惝惞惟惤惦惧惨惬惭惮惯𢛴𢛵𢛶𢜒𢜔𢜛𢜟戚戛戜戝扈挲挻㧻㧾㨀" +
+		"㨁㨂㨃㨄㨆捥捦捧捨捩捪捫捬捭据捯捰捱捲捳捴捵捶捷捸捹捺捻捼捽捾捿掀掁掂掃掄掅掆掇" +
+		"授掉掊掋掍掎掏掐掑排掓掕掖掗掘掙掚掛掜掝掞掟掠採探掤接掦控推掩措掫掬掭掮掯掳掴掵" +
+		"掶掷掸掹掺掻掼掽𢯊𢯎掲㪊㪋㪌㪍㪎啟敍敎敏敐救敒敓敔敕敖敗敘教敚敛敝斍斎斏斛斜斬断" +
+		"㫋旇旈旉旋旌旍旎族旣㫰㫲勗晗晘晙晚晛晜晝晞晡晢晣晤晥晦晧晨晩曽𣆳𣇈𣇉曹曼㬶㬷朖朘" +
+		"朙朚望㭨㭩㭪㭫㭬㭭㭮㭯㭰㭱㭲㭳㭴㭵㭷桫桬桭桮桯桰桱桲桳桴桵桶桷桸桹桺桻桼桽桾桿梀" +
+		"梁梂梃梄梅梆梇梈梉梊梋梌梍梎梏梐梑梒梓梔梕梖梗梘梙梚梛梜條梞梟梠梡梢梣梤梥梦梧梨" +
+		"梩梪梫梬梭梮梯械梱梲梳梵梶梷梸梹梺梻梼梽梾梿检棁棂楖㰯欲欳欵欶欷欸㱢殌殍殎殏殐殑" +
+		"殒殓殸殹殺殻毫毬毭毮氪氫𣱣㳫涎㴀㴄涙涪涫涬涭涮涯涰涱液涳涴涵涶涷涸涹涺涻涼涽涾涿" +
+		"淀淁淂淃淄淅淆淇淈淉淊淋淌淍淎淏淐淑淒淓淔淕淖淗淘淙淚淛淜淝淞淟淠淡淢淣淤淥淦淧" +
+		"淨淩淪淫淬淭淮淯淰深淲淳淴淵淶混淸淹淺添淽淿渀渁渂渄清渆渇済渉渊渋渌渍渎渏渐渑渒" +
+		"渓渔渕渖渗渚湴𣵾𣶏𣶶𣶷𣶸𣶹𣶺𣶻𣶼𣶽𣷣𣷸㶿烯烰烱烲烳烴烵烶烷烸烹烺烼烽烾烿焀焁焂焃" +
+		"焄焅焆焇焈焉焊焋焌焍焎焏焐焑焒焓焕焖焗焘𤉋𤉖𤉙焔爽㸺㸼㸾㹀牻牼牽牾牿犁𤙥狿猄猅猇" +
+		"猈猉猊猍猎猏猐猑猓猔猕猖猗猘猙猚猛猜猝猞猟猠猡猪率玈㻊㻌㻐珳珴珵珶珸珺珻珼珽現珿" +
+		"琀琁琂球琄琅理琇琈琊琋琌琍琎琏琐琑琒琓𤥢𤥣𤥴𤥵𤥶㼎瓠㼦瓶瓷瓸甛甜產産畡畢畣畤略畦" +
+		"畧畩異疵痊痋痌痍痎痏痐痑痒痓痔痕痖皉皎皏皐皑皲䀁䀂盒盓盔盕盖盗盘盛眥眦眭眮眯眰眱" +
+		"眲眳眴眵眶眷眸眹眺眻眼眽眾睁𥅽𥅾着矪矫砦硂硃硄硅硆硇硈硉硊硋硌硍硎硏硐硑硒硓硔硕" +
+		"硖硗硘硙硚硛祡䄄祣祤祥祧票祩祪祫祬祭祮祯視𥚃离䄻䅁䅃䅅秱秲秳秴秵秶秷秸秹秺移秼秽" +
+		"秾稆窏窐窑窒窓窔窕窚竡笖笗笘笙笚笛笜笝笞笟笠笡笢笣笤笥符笧笨笩笪笫第笭笮笯笰笱笲" +
+		"笳笴笵笶笷笸笹笺笻笼笽笾畨粒粓粔粕粖粗粘粙粚粛粜粝粣𥹉紨紩紬紭紮累細紱紲紳紴紵紶" +
+		"紷紸紹紺紻紼紽紾紿絀絁終絃組絅絆絇絈絉絊絋経𥿡𥿢绩绪绫绬续绮绯绰绱绲绳维绵绶绷绸" +
+		"绹绺绻综绽绾绿缀缁䍄䍅缻缽罣羕羚羛羜羝羞羟翇翈翉翊翋翌翍翎翏翐翑習耈耉耚耛耜耝耞" +
+		"耟聃聄聅聆聇聈聉聊聋职聍胬䏲脕脖脗脘脙脚脛脜脝脞脟脡脢脣脤脥脦脧脨脩脪脫脬脭脮脯" +
+		"脰脱脲脳脴脵脶脷脸𦛚𦛨𦛼𦤎舂舑舲舳舴舵舶舷舸船舺舻𦨭𦨮艴荙荫茝茣荰荱荲荳荴荵荶荷" +
+		"荸荹荺荻荼荽荾荿莀莁莂莃莄莅莆莇莈莉莊莋莌莍莎莏莐莑莒莓莔莕莖莗莘莙莛莜莝莞莟莠" +
+		"莡莢莣莤莥莦莧莨莩莪莫莬莮莯莰莱莲莳莴莵莶获莸莹莺莼莽𦯀𦯷𦰡莭彪處虖虗虘虙虚蚫蚭" +
+		"蚮蚯蚰蚱蚲蚳蚴蚵蚶蚷蚸蚹蚺蚻蚼蚽蚾蚿蛀蛁蛂蛃蛄蛅蛆蛇蛈蛉蛊蛋蛌蛍蛎蛏﨡𧊀𧊅𧊋衅衐" +
+		"衑衒術衔䘦袈袉袊袋袌袍袎袏袐袑袒袓袔袕袖袗袘袙袚袛袜袝袞袟袠袡袢袣袤袥袦袧袨袩袪" +
+		"被袬袭袮袰袯覂䙺規覐覑覒覓覔觋觕觖觗觘觙𧣈䚼訛訜訝訞訟訠訡訢訣訤訥訦訧訨訩訪訫訬" +
+		"設訮訯訰許訲訳𧥺谋谌谍谎谏谐谑谒谓谔谕谖谗谘谙谚谛谜谝谞谹谺谻豉䝆䝇豘豙豚豛豜豝" +
+		"豼豽貥貦貧貨販貪貫責貭貮赇赈赉赊赥赦赧䞛赹赺赻赼赽赾赿﨣趹趺趻趽趾趿跀跁跂跃跄躭" +
+		"躮躯𨈘𨈚䡆䡇䡈䡉䡊軖軗軘軙軚軛軜軝軞軟軠軡転軣𨋍辄辅辆䢛逋逌逍逎透逐逑递逓途逕逖" +
+		"逗逘這通逛逜逝逞速造逡逢連逤逥逦逧𨔁邫郔部郩郪郫郬郭郮郯郰郲郳郴郷郸都𨜏𨜓䣭酓酔" +
+		"酕酖酗酘酙酚酛酜酝酞𨠄釈野釣釤釥釦釧釨釩釪釫釬釭釮釯釰釱釲釳釴釵釶釷釸釹釺釻釼𨥈" +
+		"𨥉铏铐铑铒铓铔铕铖铗铘铙铚铛铜铝铞铟铠铡铢铣铤铥铦铧铨铩铪铫铬铭铮铯铰铱铲铳铴铵" +
+		"银铷镹镺閆閇閈閉閊𨳒阇阈阉阊阋阌阍阎阏阐陪陫陬陭陮陯陰陱陳陴陵陶陷陸陹険陼𨺬𨺲𨺳" +
+		"隿雀雩雪雫𩇕𩇫靪竟章頂頃頄颅领颇颈飡飥飦馃馄馅馆馗骐骑骒骓骔骕骖髙魚鱾鳥鸷鸸鸹鸺" +
+		"鸻鸼鸽鸾鸿鹵鹿麥麸麻黒龁龚龛",
+	// 12 strokes
+	"𠁆亁亴亵偨㑳㑺傀傁傂傃傄傅傆傇傈傉傊傋傌傍傎傏傐傑傒傓傔傕傖傗傘備傚傛傜傝傞傟傠" +
+		"傡傢傣傤傥傦傧储傩𠌊𠌥兟兠𠒣最凒凓凔凕凖凱凲凿㓻剩割剳剴創勛勜勝勞匑匒𠤣𠥔㔸博厤" +
+		"厥厦厧厨叅㖿㗁㗄㗅㗇㗊㗎啙啺啻啼啽啾啿喀喁喂喃善喅喆喇喈喉喊喋喌喎喏喐喑喒喓喔喕" +
+		"喖喗喘喙喚喛喜喝喞喟喠喡喢喣喤喥喦喧喨喩喪喫喬喭單喯喰喱喲喳喴喵喷喸喹喺喻喼喽喾" +
+		"噅𠷈𠸄𠸉𠸊𠸍𠸎𠸏𠸐𠸑𠸖𠸝嗞圌圍圎圐㙎堖堗堘堙堚堛堜堝堞堟堠堡堢堣堤堥堦堧堨堩堪堫" +
+		"堬堭堮堯堰報堳場堶堷堸堹堺堻堼堾堿塀塁塂塄塅塆塇塈𡍵𡍶𡎎𡎘𡎜壹壺壻夡奠奡奣奤奥㛵" +
+		"㜀㜁㜃㜄婷婸婹婺婻婼婽婾婿媀媁媂媃媄媅媆媇媈媉媊媋媌媍媏媑媒媓媔媕媖媗媘媙媚媛媜" +
+		"媝媞媟媠媡媢媣媤媥媦媧媨媩媪媫媬媭媮媯嫏𡞫𡞰𡞱𡞲𡞳𡞴𡞵𡟃𡟇𡟙𡟚𡟛𡟜𡟟孱𡥼𡦀孳㝢寊" +
+		"寋富寍寎寏寐寑寒寓寔寕寪𡩅𡩋尊尋尌尞尰就属屟屡𡲢𡲥崱崲崳崴崵崶崷崸崹崺崻崼崽崾崿" +
+		"嵀嵁嵂嵃嵄嵅嵆嵇嵈嵉嵋嵌嵍嵎嵏嵐嵑嵒嵓嵔嵕嵖嵗嵘嵙嵚嵛嵜嵝﨑𡺉𡺤𡺨嵫㠭巯巽𢁅𢁉帽" +
+		"帿幀幁幂幃幄幅幆幇幉𢃼幈幾庽庿廀廁廂廃廊廄弑强弻弼弽弾彘彭徚徥徦徧徨復循徫𢔰悲悳" +
+		"悶悹惁惄惉惌惎惑惒惖惠惡惢惣惥惩惪惫㥡㥢㥥惰惱惲惴惵惶惸惺惻惼惽惾惿愀愃愄愅愇愉" +
+		"愊愋愌愎愐愑愒愓愔愕愖愘愜愝愞愠愡愢愣愤愥愦慨𢜪𢝵𢞁㦸戞戟扉扊掌掔掣掰掱㨗㨘掾掿" +
+		"揀揁揂揃揄揆揇揈揉揊揋揌揍揎描提揑插揓揔揕揖揗揘揙揚換揜揝揞揟揠握揢揣揥揦揨揩揪" +
+		"揬揭揮揯揰揲揳援揵揶揷揸揹揺揻揼揽揾揿搀搁搂搃搄搅摒𢰦𢰧𢰸𢱌𢱑𢱕摡攲㪏㪐㪗敜敞敟" +
+		"敠敡敢散敤敥敦敧敨敩敪𢽴斌斐斑㪸斝𣁽𣁾斞㫀斮斯斱旐旑𣄃㫻㬀晪晫晬晭普景晰晱晲晳晴" +
+		"晵晶晷晹智晻晼晽晾晿暀暁暂暃暑𣇷𣇸𣇹𣈏曾替朁朂㬸朜朝朞期梴㭶㭸㭹㭺㭻㭼㭽㭾㭿㮀棃" +
+		"棄棅棆棇棈棉棊棋棌棍棎棏棐棑棒棓棔棕棖棗棘棙棚棛棜棝棞棟棠棡棢棣棤棥棦棧棨棩棪棫" +
+		"棬棭森棯棰棱棲棳棴棵棶棷棸棹棺棻棼棽棾棿椀椁椂椃椄椅椆椇椈椉椊椋椌植椎椏椐椑椒椓" +
+		"椔椕椖椗椘椙椚椛検椝椞椟椠椡椢椣椤椥椦椧椨椩椪椫椬椭椮𣓥𣔙𣔰楮楰欹欺欻欼欽款欿歮" +
+		"歯㱤㱦㱨㱩殔殕殖殗殘殙殚殼殽殾毯毰毱毲毳毴毵毶氬氭氮氯氰淼淾㴓㴝㴠㴢渃渘渙減渜渝" +
+		"渞渟渠渡渢渣渤渥渦渧渨温渪渫測渭渮港渰渱渲渳渴渵渶渷游渹渺渻渼渽渾渿湀湁湂湃湄湅" +
+		"湆湇湈湉湊湋湌湍湎湏湐湑湒湓湔湕湖湗湘湙湚湛湜湝湞湟湠湡湢湣湤湥湦湧湨湩湪湫湭湮" +
+		"湯湰湱湲湳湵湶湷湸湹湺湻湼湽湾湿満溁溂溃溄溅溆溇溈溉溊溋溌滋滞𣸑𣸬𣸭𣸮𣸯𣸰𣸱𣸹烻" +
+		"㷆㷇㷉㷌㷍焙焚焛焜焝焞焟焠無焢焣焤焥焦焧焨焩焪焫焬焭焮焯焰焱焲焳焴焵然焷焸焹焺焻" +
+		"焼焽焾焿煀煮𤉶𤉷𤉸𤊄𤊓𤊕𤊥爲牋牌牍𤗈牚㹃犀犂犃犄犅犆犇犈犉犊犋𤙴猆猋猌猒猫猢猣猤" +
+		"猥猦猧猨猩猬猭猯猰猱猲猳猴猵猶猸猹𤟠㻑㻖㻚㻛珷琔琕琖琗琘琙琚琛琜琝琟琠琡琢琣琤琥" +
+		"琦琨琩琪琫琬琭琮琯琰琱琲琳琴琵琶琷琸琹琺琻琼𤥻𤥿𤦂𤦈𤦉𤦊𤦋𤦌𤦍𤦎𤦏𤦔𤦤𤦧𤦩𤦫𤦬𤦭" +
+		"瓹瓺瓻瓼甤甥甦甯𤰉番畫畬畭畮畯畲畳畴畱疎疏㾓㾘痗痘痙痚痛痜痝痞痟痠痡痢痣痤痥痦痧" +
+		"痨痩痪痫𤶸登發皒皓皔皕皖皳皴䀃盙盚盜䀹䁀睂睃睄睅睆睇睈睉睊睋睌睍睎睏睐睑矞矟矬短" +
+		"硜硝硞硟硠硡硢硣硤硥硦硧硨硩硪硫硬硭确硯硰硱硲硳硴硵硶硷䄉祦祰祱祲祳祴祵祶祷祸禄" +
+		"𥚕禼秿稀稁稂稃稄稅稇稈稉稊程稌稍税𥟇窖窗窘窙窛窜窝竢竣竤童竦竧䇭䇮䇯笿筀筁筂筃筄" +
+		"筅筆筇筈等筊筋筌筍筎筏筐筑筒筓答筕策筗筘筙筚筛筜筝筬䊃粞粟粠粡粢粤粥粦粧粨粩粪粫" +
+		"粬粭紪紫絍絎絏結絑絒絓絔絕絖絗絘絙絚絜絝絞絟絠絡絢絣絤絥給絧絨絩絪絫絬絭絮絯絰統" +
+		"絲絳絴絵絶絷絾䌻缂缃缄缅缆缇缈缉缊缋缌缍缎缏缐缑缒缓缔缕编缗缘缾缿罀罤罥罦䍮䍯羠" +
+		"羡羢翓翔翕翖翗翘翙翚耋耠聎聏聐聑聒聓联聠𦕲胔胾脔脠㬹䐀䐁䐂脹脺脻脼脽脾脿腀腁腂腃" +
+		"腄腅腆腇腈腉腊腋腌腍腎腏腑腒腓腔腕腖腗腘腙腚𦜖𦝁腴臦臮臯臰𦤑臵臶臷臸臹舃舄舒舜舼" +
+		"舽舾舿𦨴艵䒰䒷䓀茒茻荆莚莾莿菀菁菂菃菄菅菆菇菈菉菊菋菌菍菎菏菐菑菒菓菔菕菖菗菘菚" +
+		"菛菜菝菞菟菠菡菢菣菤菥菦菧菨菩菪菫菬菭菮華菰菱菲菳菴菵菶菷菸菹菺菻菼菽菾菿萀萁萂" +
+		"萃萄萅萆萇萈萉萊萋萌萍萎萏萐萑萒萓萔萕萖萗萘萙萚萛萜萝萞萟萠萡萢萣萤萦萧著𦱀𦱾𦱿" +
+		"𦲀𦲁𦲂𦲤𦲷𦲸𦲽𦳀萸虛虝䖭䖯蚈蛐蛑蛒蛓蛔蛕蛗蛘蛙蛚蛛蛜蛝蛞蛟蛠蛡蛢蛣蛤蛥蛦蛧蛨蛩蛪" +
+		"蛫蛬蛭蛮蛯蛰蛱蛲蛳蛴𧊲𧊶衆衇衈衉衕衖街袱袲袳袴袵袶袷袸袹袺袻袼袽袾袿裀裁裂裃裄装" +
+		"裆裇裈裉裗𧚔褁覃覄覙覕覗覘覚觌觍觚觛觝觞䛏䛐訴訵訶訷訸訹診註証訽詀詁詂詃詄詅詆詇" +
+		"詈詉詊詋詌詍詎詏詐詑詒詓詔評詖詗詘詙詚詛詜詝詞詟詠𧦠谟谠谡谢谣谤谥谦谧䝈豞豟豠象" +
+		"豾豿貀貁貂貃貯貰貱貳貴貵貶買貸貹貺費貼貽貾貿賀賁𧵓𧵔赋赌赍赎赏赐赑赒赓赔赕䞡䞣趀" +
+		"趁趂趃趄超趆趇趈趉越趋䟭跅跆跇跈跉跊跋跌跍跎跏跑跒跓跔跕跖跗跘跙跚跛跜距跞践𧿹𨀂" +
+		"𨀉躰䡒軤軥軦軧軨軩軪軫軬軮軯軰軱軲軳軴軵軶軷軸軹軺軻軼軽𨋢辇辈辉辊辋辌辍辎辜辝𨐒" +
+		"逨逩逪逫逬逭逮逯逰週進逳逴逵逶逷逸逹逺逻𨔼𨔽郵䣐郹郻郼郾郿鄀鄁鄂鄃鄄鄅鄆鄇鄈鄉鄊" +
+		"鄬䣳酟酠酡酢酣酤酥釉释量䤞䤠釽釾釿鈀鈁鈂鈃鈄鈅鈆鈇鈈鈉鈊鈋鈌鈍鈎鈏鈐鈑鈒鈓鈔鈕鈖" +
+		"鈗鈘鈙鈚鈛鈜鈝鈞鈟鈠鈡鈢鈣鈤鈥鈦鈧鈨鈩鈪鈫鈬𨥖𨥤铸铹铺铻铼铽链铿销锁锂锃锄锅锆锇" +
+		"锈锉锊锋锌锍锎锏锐锑锒锓锔锕镻開閌閍閎閏閐閑閒間閔閕閖閗阑阒阓阔阕陲陻陽陾陿隀隁" +
+		"隂隃隄隅隆隇隈隉隊隋隌隍階隐𨻙𨻧雁雂雃雄雅集雇雈雬雭雮雯雰雱雲雳𩂈𩂋靓靔靟靫靬靭" +
+		"靮靯靰靱韌韩項順頇須颉颊颋颌颍颎颏颩颪𩖞飓飧飨飩飪飫飭飯飰飲馇馈馊馋馭馮骗骘骙骚" +
+		"骛骩髠鱿鲀鲁鲂鲃鳦鹀鹁鹂鹃鹄鹅鹆鹇鹈黃黄黍黑黹鼋龂",
+	// 13 strokes
+	"亂亃亄亶亷㑽㑾傪傫催傭傮傯傰傱傲傳傴債傶傷傸傹傺傻傼傽傾傿僀僁僂僃僄僅僆僇僈僉僊" +
+		"僋僌働𠍁𠍅𠍆𠍇𠎵兡兾兿凗剷剸剹剺剻剼剽剾剿募勠勡勢勣勤勥勦勧㔲㔳㔴匯厀厁厪厫厯叠" +
+		"﨎㗒㗖㗛㗝㗠喍喿嗀嗁嗂嗃嗄嗅嗆嗇嗈嗉嗊嗋嗌嗍嗎嗏嗐嗑嗒嗓嗔嗕嗖嗗嗘嗙嗚嗛嗜嗝嗟嗠" +
+		"嗡嗢嗣嗤嗥嗦嗧嗨嗩嗪嗫嗬嗭嗮嗯嗰嗱嗲嗳嗴嗵𠹌𠹭𠹳𠹵𠹶𠹷𠹸𠹹𠹺𠹻𠺌𠺖𠺘𠺝𠺢𠺪𠺫𠺬𠺶" +
+		"圑園圓圔圕㙟堽塃塉塊塋塌塍塎塏塐塑塒塓塔塕塖塗塘塙塚塛塜塝塞塟塠塡塢塣塤塥塦塧塨" +
+		"塩塪填塬塭塮塯塰塱𡏅𡏆壼奦奧奨𡙡㜈㜊㜍媐媰媱媲媳媴媵媶媷媸媹媺媻媼媽媾媿嫀嫁嫂嫃" +
+		"嫄嫅嫆嫇嫈嫉嫊嫋嫌嫍嫎嫐嫑嫒嫓嫔𡟯𡟵𡟶𡟸𡟹𡟺𡟻𡟼孴𡦃𡦈孶㝦寖寗寘寙寚寛寜寝尟尠尲" +
+		"尳尴𡲬㟲㟸嵊嵞嵟嵠嵡嵢嵣嵤嵥嵦嵧嵨嵩嵪嵬嵭嵮嵯嵰嵱嵲嵳嵴嵵嵶𡻈𡻕巰幊幋幌幍幎幏幹" +
+		"廅廆廇廈廉廋廌𢉼弒弿彀彁彂彙彚𢑥彮徬徭微徯徰㥣㥤㥦想惷惹愁愂愆愈愍意愗愙愚愛感愧" +
+		"愩愪愫愭愮愯愰愱愲愴愵愶愷愹愺愼愽愾慀慃慄慅慆慉慊慌慍慎慏慑𢞴𢞵𢟍戦戠戡戢戣戤戥" +
+		"揧揫揱㨠㨣㨦㨩㨪搆搇搈搉搊搋搌損搎搏搐搑搒搓搔搕搖搗搘搙搚搛搜搝搞搟搠搡搢搣搤搥" +
+		"搦搧搨搩搪搬搭搮搯搰搲搳搵搶搷搸搹携搼搽搾摀摁摂摃摄摅摆摇摈摉摊𢱢𢲈𢲛𢲡𢲩𢲲揅搱" +
+		"敭敫敬敮敯数斒𩖰斟新旒旓旔旕旤晸㬁㬂㬃㬄㬅㬆㬇㬈㬉㬊㬋㬌暄暅暆暇暈暉暊暋暌暍暎暏" +
+		"暐暒暓暔暕暖暗暘暙𣈥𣈯𣈱𣈲𣈳𣈴會朠朡㮖㮙椯椰椱椲椳椴椵椶椷椸椹椺椻椼椽椾椿楀楁楂" +
+		"楃楄楅楆楇楈楉楊楋楌楍楎楏楐楑楒楓楔楕楗楘楙楚楛楜楝楞楟楠楡楢楣楤楥楦楧楨楩楪楫" +
+		"楬業楯楱楲楳楴極楶楷楸楹楺楻楼楽楾楿榀榁概榃榄榅榆榇榈榉榋榌榔榘﨓𣕚𣕧𣖕𣖙𣖜㰼㰾" +
+		"歀歁歂歃歄歅歆歇歈歱歲歳㱮殛殜殿毀毁毂𣪧毓毷毸毹毺毻毼毽氱湬㴦㴲㴳㴻溍溎溏源溑溒" +
+		"溓溔溕準溗溘溙溚溛溜溝溞溟溠溡溢溣溤溥溦溧溨溩溪溫溬溭溮溯溰溱溲溳溴溵溶溷溸溹溺" +
+		"溻溼溽溾溿滀滁滂滃滄滅滆滇滈滉滊滍滏滐滑滒滓滔滖滗滘滙滛滜滝滟滠满滢滣滤滥滦滧滨" +
+		"滩滪漓𣺈𣺉𣺊𣺋𣺹𣺿滚㮡㷓㷛煁煂煃煄煅煆煇煈煉煊煋煌煍煎煏煐煑煒煓煔煖煗煘煙煚煜煝" +
+		"煞煟煠煡煢煣煤煥煦照煨煩煪煫煬煭煯煰煱煲煳煴煵煶煷煸煺𤋁𤋉𤋊𤋮𤋺𤔡爺牃牎牏牐牑牒" +
+		"㹈犌犍犎犏犐犑𤚗献猷獁猺猻猼猽猾猿獀獂獅獆獇獈獉獊𤠒𤠣㻗琧㻞㻡㻢琞琽琾琿瑀瑁瑂瑃" +
+		"瑄瑅瑆瑇瑈瑉瑊瑋瑌瑍瑎瑏瑐瑑瑒瑓瑔瑕瑖瑗瑘瑙瑚瑛瑜瑝瑞瑟𤦷𤦸𤦹𤦺𤦻𤧅𤧐𤧚𤧞𤧟𤧣𤧥" +
+		"瑯瓡瓽瓾瓿甁甝甞㽣畵當畷畸畹畺𤲞𤲟痬痭痮痯痰痱痲痳痴痵痶痷痸痹痺痻痼痽痾痿瘀瘁瘂" +
+		"瘃瘄瘅瘆𤷪𤷫瘏瘐皗皘皙𤾂𤾆皵䀄盝盞盟䁅睒睓睔睕睖睗睘睙睚睛睜睝睞睟睠睢督睤睥睦睧" +
+		"睨睩睪睫睬睭𥇍𥇣𥇦𥇧睡睹矠矮䂻䂿硸硹硺硻硼硽硿碀碁碂碃碄碅碆碇碈碉碊碋碌碍碎碏碐" +
+		"碑碒碓碔碕碖碗碘碙碚碛碜碰䄎祹祺祻祼祽祾祿禀禁禂禃禅禆禽萬稏稐稑稒稓稔稕稖稗稘稙" +
+		"稚稛稜稝稞稟稠稡稢稣稤稥𥟟𥟠𥟡窞窟窠窡窢窣窤窥窦窧𥦬䇏竨竩竪竫𥪕䇸䇹䇻䇼䇽䇾䇿䈀" +
+		"筞筟筠筡筢筣筤筥筦筧筨筩筪筫筭筮筯筰筱筲筳筴筶筷筸筹筺筻筼筽签筿简節𥭴𥮉䊌粮粯粰" +
+		"粱粲粳粴粵糀𥺁𥺂𥺃絛絸絹絺絻絼絽絿綀綁綂綃綄綅綆綇綈綉綊綋綌綍綎綏綐綑綒經綔綕綗" +
+		"綘継続綛𦀩缙缚缛缜缝缞缟缠缡缢缣缤罧罨罩罪罫罬罭置署𦋐羣群羥羦羧羨義羪翛翜翝耡耢" +
+		"聕聖聗聘肄肅肆䐓幐腛腜腝腞腟腠腡腢腣腤腥腦腧腨腩腪腫腬腭腮腯腰腱腲腳腵腶腷腸腹腺" +
+		"腻腼腽腾舅舝艀艁艂艃艄艅艆艇艈艉𦩂䓅䓎莻菙营萨萩萪萫萭萮萯萰萱萲萳萴萵萶萷萹萺萻" +
+		"萼落萾萿葀葁葂葃葄葅葆葇葈葉葊葋葌葍葎葏葐葑葒葓葔葕葖葘葙葚葛葜葝葞葟葠葡葢董葤" +
+		"葥葦葧葨葩葪葫葬葭葮葯葰葱葲葳葴葵葶葷葸葹葺葻葼葽葾葿蒀蒁蒂蒃蒄蒅蒆蒇蒈蒉蒋蒌蒍" +
+		"蒎蒏𦳃𦳑𦴢𦴣𦴤𦴥𦴦𦴧𦴨𦴩𦴪𦵑蓅蓈蓱蔇虜虞號蛖蛵蛶蛷蛸蛹蛺蛻蛼蛽蛾蛿蜀蜁蜂蜃蜄蜅蜆" +
+		"蜇蜈蜉蜊蜋蜌蜍蜎蜏蜐蜓蜔蜕蜖蜗蝆𧋦蝍衘衙裊裋裌裍裎裏裐裑裒裓裔裕裖裘裙裚裛補裝裞" +
+		"裟裠裡裣裤裥覅䚀覛覜觎觜觟觠觡觢解觤觥触觧訾訿詡詢詣詤詥試詧詨詩詪詫詬詭詮詯詰話" +
+		"該詳詴詵詶詷詸詹詺詻詼詽詾詿誀誁誂誃誄誅誆誇誈誉誊誠𧧝谨谩谪谫谬谼豊豋豢豣豤豥豦" +
+		"貄貅貆貇貈貉貊貲賂賃賄賅賆資賈賉賊賋賌賍賎𧵦𧵳𧶄赖赗赨赩赪䞦趌趍趎趏趐趑趒趓趔跐" +
+		"趼跟跠跡跢跣跤跥跦跧跨跩跪跫跬跭跮路跰跱跲跳跴跶跷跸跹跺跻𨀞𨀣𨀤䠷躱躲軭軾軿輀輁" +
+		"輂較輄輅輆輇輈載輊輋輌辏辐辑辒输辔辞辟辠農逼逽逾逿遀遁遂遃遄遅遆遇遈遉遊運遌遍過" +
+		"遏遐遑遒道達違遖遗𨕬郌鄋鄌鄍鄎鄏鄐鄑鄒鄓鄔鄕鄖鄗酦酧酨酩酪酫酬酭酮酯酰酱𨠫鈮鈯鈰" +
+		"鈱鈲鈳鈴鈵鈶鈷鈸鈹鈺鈻鈼鈽鈾鈿鉀鉁鉂鉃鉄鉅鉆鉇鉈鉉鉊鉋鉌鉍鉎鉏鉐鉑鉒鉓鉔鉕鉖鉗鉘" +
+		"鉙鉚鉛鉜鉝鉞鉟鉠鉡鉢鉣鉤鉥鉦鉧鉨鉩鉪鉫鉬鉭鉮鉯鉰鉱鉲鉳鉴銏𨥨𨥬𨥾锖锗锘错锚锛锜锝" +
+		"锞锟锠锡锢锣锤锥锦锧锨锩锪锫锬锭键锯锰锱䦉閘閙閚閛閜閝閞閟閠阖阗阘阙随䧟隑隒隓隔" +
+		"隕隖隗隘﨩雉雊雋雍雎雏雴雵零雷雸雹雺電雼雽雾𩂓靕靖靲靳靴靵靶靷靸靹韪韫韮韴韵頉䪴" +
+		"頊頋頌頍頎頏預頑頒頓颐频颒颓颔颕颖颫颬飔䬦飬飮飱飳飴飵飶飷飹飻飼飽飾飿馉馌馍馎馏" +
+		"馐馚馯馰馱馲馳馴馵骜骝骞骟骪骫骬骭骮𩨨髡髢鬽魛魜魝魞鲄鲅鲆鲇鲈鲉鲊鲋鲌鲍鲎鲏鲐鳧" +
+		"鳨鳩鳪鳫鳭鳮鳯鳰鹉鹊鹋鹌鹍鹎鹏鹐鹑鹒鹓鹔麀麁麂𪋿黽鼌鼎鼓鼔鼠龃龄龅龆",
+	// 14 strokes
+	"𠁎𢆡僎像僐僑僒僓僔僕僖僗僘僙僚僛僜僝僞僟僠僡僢僣僤僥僦僧僨僩僪僫僬僭僮僯僰僱僳僴" +
+		"僷𠍾𠍿𠎀𠎠𠎧僲兢冩凘凳凴㔀㔄㔆劀劁劂劃劄㔢勨勩勪勫勬勭㔵匰匱匲㕑厬厭厮厰叆𠬍㕡嗶" +
+		"嗷嗸嗹嗺嗻嗼嗽嗾嗿嘀嘁嘂嘃嘄嘅嘆嘇嘈嘉嘊嘋嘌嘍嘎嘏嘐嘑嘒嘓嘔嘕嘖嘗嘘嘙嘚嘛嘜嘝嘞" +
+		"嘡嘢嘣嘤嘥嘦嘧噑𠻗𠻘𠻝𠻸𠻹𠻺𠻻𠼝𠼦𠼭𠼮𠼰𠼱𠼻𠽌嘟嘨圖圗團圙㙥㙦塲塳塴塵塶塷塸塹塺" +
+		"塻塼塽塾塿墁墂境墄墅墆墇墈墉墊墋墌墍墎墏墐墑墒墓墔墕墖増墘墙墚墛𡏭𡏾𡐓𡐖墭壽壾夐" +
+		"夢夣夤夥奩奪奫奬㜜㜞㜠㜢嫕嫖嫗嫘嫙嫚嫛嫜嫝嫞嫟嫠嫡嫢嫣嫤嫥嫦嫧嫨嫩嫪嫫嫬嫭嫮嫯嫰" +
+		"嫱嫲𡠠𡠨𡠩𡠪𡠭𡠹𡠺𡠻𡡀𡡅嫳孵孷𡦖寞察寠寡寢寣寤寥實寧寨對尡屢屣㟻㠀㠁㠄嵷嵸嵹嵺嵻" +
+		"嵼嵽嵾嵿嶀嶁嶂嶃嶄嶅嶆嶇嶈嶉嶊嶋嶌嶍嶎幑幒幓幔幕幖幗幘幙幛𢄪幣廍廎廏廐廑廒廓廔廕" +
+		"廖廗廘廙廜弊㣃彃彄彅彆㣑彯彰徱徳徴𢕔徶愨愬愳愸愻愿慁慂慇慈態慐㦀慒慓慔慖慘慚慛慞" +
+		"慟慠慡慢慣慥慩慪慬慯慱慲慳慴慵慷慺慻慽憀憁憆憈𢠃戧戨戩截戫戬搫搴搻搿㨯㨱㨲㨳㨴㨵" +
+		"㨶㨷㨸㨹摋摌摍摎摏摐摑摓摔摕摗摘摙摚摛摜摝摞摟摠摢摣摤摥摦摧摪摫摬摭摱摲摳摴摵摶" +
+		"摷摸摺摻摼摽摾摿撁撂撄撇𢲷𢳂𢳆𢳉𢴇𢴈𢴒摖撦敱敲敳斠斡斲𣂷旖旗㬍㬎㬏㬐暚暛暜暝暞暟" +
+		"暠暡暢暣暤暥暦暧暨𣉢朄朅㬺㬻朢㮼榊榍榎榏榐榑榒榓榕榖榗榙榚榛榜榝榞榟榠榡榢榣榤榥" +
+		"榦榧榨榩榪榫榬榭榮榯榰榱榲榳榴榵榶榷榸榹榺榻榼榽榾榿槀槁槂槃槄槅槆槇槈槉槊構槌槍" +
+		"槎槏槐槑槒槓槔槕槖槗様槙槚槛槜槝槞槟槠槡樮﨔𣗍𣗎𣗏𣗳𣘀樃歉歊歋歌歍歰歴殝殞殟殠殡" +
+		"毃毄𣫺毾氲氳滎㴽㵆滌滫滬滭滮滯滰滱滲滳滴滵滶滷滸滹滺滻滼滽滾滿漁漂漃漄漅漆漇漈漉" +
+		"漊漌漍漎漏漑漒演漕漖漗漘漙漚漛漜漝漞漟漠漡漢漣漤漥漧漨漩漪漫漬漭漮漯漰漱漲漳漴漵" +
+		"漶漷漸漹漺漻漼漾潀潂潃潄潅潆潇潈潉潊潋潌潍𣻗𣻷𣻸𣻹𣻺𣻻𣻼𣼵𣽁潎潳煕煛㷧㷨煹煻煼煽" +
+		"煾煿熀熁熂熃熄熅熆熇熈熉熊熋熌熍熎熏熐熑熒熓熔熕熖熗熘熙蒸𤌍𤌚𤌴𤍈爳爾牄㸢牓牔犒" +
+		"犓犔犕犖犗獓獃獄獌獍獏獐獑獒獔獕㻧㻩瑠瑡瑢瑣瑤瑥瑦瑧瑨瑪瑫瑭瑮瑰瑱瑲瑳瑴瑵瑶瑷瑸" +
+		"𤧬𤧭𤧶𤧷𤧸𤧹𤧻𤨎𤨒𤨓甀甂甃甄甅甆𤭮甧畻畼畽疐疑瘇瘈瘉瘊瘋瘌瘍瘎瘑瘒瘓瘔瘕瘖瘗瘘瘧" +
+		"皶皷皸皹盠盡盢監䁓睮睯睰睱睲睳睴睵睶睷睸睺睻睼睽睾睿瞀瞁瞂瞃瞄瞅瞆𥈠𥈡䃈硾碝碞碟" +
+		"碠碡碢碣碤碥碦碧碨碩碪碫碬碭碮碯碱碲碳碴碵碶碷碸碹磁禇禈禉禊禋禌禍禎福禐禑禒禓禔" +
+		"禕禖禗禘禙䅧稦稧稨稩稪稫稬稭種稯稰稱稲稳穊稵窨窩窪窫窬窭𥧌竬竭端竰𥪜竮筵䈁䈂䈃䈄" +
+		"䈅䈆䈇䈈䈉䈊䈋䈌䈍箁箂箃箄箅箆箇箈箉箊箋箌箍箎箏箐箑箒箓箔箕箖算箘箙箚箛箜箝箞箟" +
+		"箠管箢箣箤箥箦箧箨箩箪箫𥮳𥮴𥯆箸粶粷粸粹粺粻粼粽精粿糁𥺦𥺼綖緐䋨䋩䋬䋭䋱綜綝綞綟" +
+		"綠綡綢綣綤綥綦綧綨綩綪綫綬維綮綯綰綱網綳綴綵綶綷綸綹綺綻綼綽綾綿緀緁緂緃緄緅緆緇" +
+		"緈緉緊緋緌緍緎総緑緒緔緕𦁈𦁤缥缦缧缨缩缪缫罁罂罯罰罱罳罴羫翞翟翠翡翢翣翤𦑊翥耣耤" +
+		"耥䎺聙聚聛聜聝聞聟聡聢聣𦖠肇肈腐䐠䐥䐦腿膀膁膂膃膄膅膆膇膈膉膊膋膌膍膎膏膑𦞙𦞳𦞴" +
+		"𦟌臧臺與舓舔舕舞艊艋艌艍𦩑𦩒䓝䓟䓤䓩䓪䓫䓬蒐蒑蒒蒓蒔蒕蒖蒗蒘蒙蒚蒛蒜蒝蒞蒟蒠蒡蒢" +
+		"蒣蒤蒥蒦蒧蒨蒩蒪蒫蒬蒭蒮蒯蒰蒱蒲蒳蒴蒵蒶蒷蒹蒺蒻蒼蒽蒾蒿蓀蓁蓂蓃蓄蓆蓇蓉蓊蓋蓌蓍" +
+		"蓎蓏蓐蓑蓒蓓蓔蓕蓖蓗蓘蓙蓚蓛蓜蓝蓟蓡蓢蓣蓤蓦𦵴𦶠𦶡𦶢𦶣𦶤𦶥𦶦𦶧𦶮𦷜𦷪𦷫𦷰蓥虠虡𧇍" +
+		"蜑蜒蜫蜘蜙蜚蜛蜜蜝蜞蜟蜠蜡蜢蜣蜤蜥蜦蜧蜨蜩蜪蜬蜭蜮蜯蜰蜱蜲蜳蜴蜵蜶蜷蜸蜹蜺蜻蜼蜽" +
+		"蜾蜿蝀蝁蝂蝃蝄蝅蝇蝈蝉蝊蝋蝕蝫裢䘻裧裨裩裪裫裬裭裮裯裰裱裲裳裴裵裶裷裸裹裺裻裼製" +
+		"裾裿褀褂褃褄褚覝覞覟覠覡觏觨觩觪觫誋誌認誎誏誐誑誒誓誔誖誗誘誙誚誛誜誝語誟誡誢誣" +
+		"誤誥誦誧誨誩說誫説読誮𧧽𧨊𧨎谭谮谯谰谱谲谽豧豨豩豪貋貌貍㕢賏賐賑賒賓賔賕賖賗賘𧶏" +
+		"𧶘赘赙赚赛赫趕趖趗趘趙趚䟴跼跽跾跿踀踁踂踃踄踅踆踇踈踉踊踋踌踍踎𨁈躳躴躵輍輎輏輐" +
+		"輑輒輓輔輕𨌆𨌘辕辖辗辡辢辣遘遙遚遛遜遝遞遟遠遡遢遣遤遥郒鄘鄙鄚鄛鄜鄝鄞鄟鄠鄡鄢鄣" +
+		"鄤鄥䣺酲酳酴酵酶酷酸酹酺酻酼酽酾酿鈭䤤䤥䤦䤪鉵鉶鉷鉸鉹鉺鉻鉽鉾鉿銀銁銂銃銄銅銆銇" +
+		"銈銉銊銋銌銍銎銐銑銒銓銔銕銖銗銘銙銚銛銜銝銞銟銠銡銢銣銤銥銦銧銨銩銪銫銬銭銮銯銰" +
+		"銱𨦉𨦨𨦪𨦫鋮鉼锲锳锴锵锶锷锸锹锺锻锼锽锾锿镀镁镂镃镄镅閡関閣閤閥閦閧閨閩閪阚隙隚" +
+		"際障隝隞隟隠隡雌雐雑雒𨿅䨏雿需霁𩂯𩂰𩂱靗靘静靤靺靻靼靽靾靿鞀鞁鞂鞃鞄鞅鞆韍韎韬韶" +
+		"韷𩐝頙䪸頔頕頖頗領頚颗䫿䬀颭颮颯颰颱𩖸飖飕飗䬬飸餀餁餂餃餄餅餆餇餉餌餎餏馑馒䭯馛" +
+		"馜馝䭻䭾馶馷馸馹馺馻馼馽馾馿駀駁駂駃駄駅駆駇骠骡骢䯈骯骰骱髚髣髤髥髦髧髨髩髪𩬅𩬎" +
+		"鬦鬾鬿魀魁魂𩲭魟魠魡魢𩵚鲑鲒鲓鲔鲕鲖鲗鲘鲙鲚鲛鲜鲝鲞鲟鳱鳲鳳鳴鳵鳶鹕鹖鹗鹙鹚鹛鹜" +
+		"麧麼麽鼻齊龇龈",
+	// 15 strokes
+	"㒓㒖㒘僵僶僸價僺僻僼僽僾僿儀儁儂儃億儅儆儇儈儉儊儋儌儍儎儏𠏉𠏋儰凙凚凛凜𠘑劅劆劇" +
+		"劈劉劊劋劌劍劎劏勮勯勰勱勲匔匳厱厲𠪴㕙叇噓㗱㗲㗳嘠嘩嘪嘫嘬嘭嘮嘯嘰嘱嘲嘳嘴嘵嘶嘷" +
+		"嘸嘹嘺嘻嘼嘽嘾嘿噀噁噂噃噄噆噇噈噉噊噋噌噍噎噏噐噒噔噖噗噘噙噚噛噜噝噴𠽤𠾍𠾐𠾭𠾴" +
+		"𠾵𠾶𠾼𡀔圚墀墜墝增墟墠墡墢墣墤墥墦墧墩墪墫墬墮墯墰墱墲墳墴墵墶墷墸墹𡐤𡐿𡑒𡑔𡑕壿" +
+		"夀𡕷夦奭㜣㜥㜦嫴嫵嫶嫷嫸嫹嫺嫻嫼嫽嫾嫿嬀嬁嬂嬃嬄嬅嬆嬇嬈嬉嬊嬋嬌嬍嬎嬏𡡒𡡞𡡡𡡢𡡣" +
+		"𡡤𡡷𡡻𡢃𡢄𡢅㝯審寫寬寭寮導𡭄尵㞠層履屦屧㠏嶏嶐嶑嶒嶓嶔嶕嶖嶗嶘嶙嶚嶛嶜嶝嶞嶟嶠嶡" +
+		"嶢嶣嶤嶥𡼏𡼕巤㡡幚幜幝幞幟幠幡幢幤幥幩廚廛廝廞廟廠廡廢廣廤彇彈彉影徲徵德徸徹徺慕" +
+		"慗慙慜慝慤慦慧慫慮慰慶慸慹慼慾慿憂憃憄憅憇㦉㦊㦒慭憉憋憍憎憏憐憒憓憔憕憘憚憛憜憞" +
+		"憟憡憢憣憤憦憧憪憫憬憭憮憯憰憱憳𢡟𢡠𢡱戭戮戯㨼摨摩摮摯摰摹撀撃㩋撅撆撈撊撋撌撍撎" +
+		"撏撐撑撒撓撔撕撖撗撘撙撚撛撜撝撞撟撠撡撢撣撤撥撧撨撩撪撫撬播撮撯撰撱撲撳撴撵撶撷" +
+		"撸撹撺擆𢵄𢵌𢵧敵敶敷數敹敺敻𢿌斳𣂼㬑㬒㬓㬔㬕㬖暩暪暫暬暭暮暯暰暱暲暳暴暵暶暷𣊁𣊊" +
+		"暼㬼㬽㬾膤槩㮾㯂㯄槢槣槤槥槦槧槨槪槫槬槭槮槯槰槱槲槳槴槵槶槷槸槹槺槻槼槽槾槿樀樁" +
+		"樂樄樅樆樇樈樉樊樋樌樍樎樏樐樑樒樓樔樕樖樗樘標樚樛樜樝樞樟樠模樢樣樤樥樦樧権横樫" +
+		"樬樭樯樰樱橥𣘚𣘼𣙀𣙙𣙟𣙷歎歏歐歑歒歓歵歶㱳㱴殢殣殤殥殦毅毆毿氀氁氂滕漀漐漦漿潁㵌" +
+		"㵎㵑漋漽潏潐潑潒潓潔潕潖潗潘潙潚潛潜潝潟潠潡潢潣潤潥潦潧潨潩潪潫潬潭潮潯潰潱潲潴" +
+		"潵潶潷潸潹潺潻潼潽潾潿澁澂澄澅澆澇澈澉澊澋澌澍澎澏澐澑澒澓澔澕澖澗澘澚澛澜澝濐𣽊" +
+		"𣽿𣾀𣾁𣾂𣾏𣾴𣾷濆熦㷫熚熛熜熝熞熟熠熡熢熣熤熥熧熨熩熪熫熬熭熮熯熰熱熲熳熴熵黙𤍢𤍣" +
+		"𤍤𤍥𤎌𤎖𤎜噕爴牅牕牖牗犘犙犚犛𤛔獎獋獖獗獘獙獚獛獜獝獞獟獠獡獢獤𤢂瑩瑬㻫㻰㻳㻴瑹" +
+		"瑺瑻瑼瑽瑾璀璁璂璃璄璅璆璇璈璉璊璋璌璎璓𤨕𤨡𤨢𤨣𤨤𤨥𤨦𤨧𤨨𤨩𤨪𤨾甇甈甉㽓𤯵畾畿瘟" +
+		"㾷㿀瘙瘚瘛瘜瘝瘞瘠瘡瘢瘣瘤瘥瘦瘨瘩瘪瘫𤸻𤹐㿥皚皛皜皝皞𤾗𤾚皺盤䁗瞇瞈瞉瞊瞋瞌瞍瞎" +
+		"瞏瞐瞑瞒瞓𥉐確碻碼碽碾碿磀磂磃磄磅磆磇磈磉磊磋磌磍磎磏磐磑磒磓磔磕磗磘磙磤𥔱𥔵𥔿" +
+		"禚禛禜禝禞禟禠禡禢禣𥛣䅬䅮䅵稴稶稷稸稹稺稻稼稽稾稿穀穁穂穃𥡗窮窯窰窱窲窳窴䈎䈏䈐" +
+		"䈑䈒䈓䈔䈕䈚䈜䈠䈢䈣䈦䈩箬箭箮箯箰箱箲箳箴箵箶箷箹箺箻箼箽箾箿篁篂篃範篅篆篇篈篊" +
+		"篋篌篍篎篏篐篑篒篓𥯤𥯨𥰁𥰆䊔糂糃糄糅糆糇糈糉糊糋糌糍糎𥻗𥻘䋴䋻䋼䌀䌁䌄緓緖緗緘緙" +
+		"線緛緜緝緞緟締緡緢緣緤緥緦緧編緩緪緫緬緭緮緯緰緱緲緳練緵緶緷緸緹緺緻緼緽緾緿縀縁" +
+		"縂縃縄縅縆縇𦂃𦂗𦂤𦂥䌾缬缭缮缯罵罶罷罸羬羭羮羯羰翦翧翨翩翪翫翬翭𦑩䎬耦耧聤聥聦聧" +
+		"聨聩聪聫𦖭䐭䐳䐴膒膓膔膕膖膗膘膙膚膛膜膝膞膟膠膡膢膣臱舖舗䑺艎艏艐艑艒艓艔䓴蒊蓠" +
+		"蓧蓨蓩蓪蓫蓬蓭蓮蓯蓰蓲蓳蓴蓵蓶蓷蓸蓹蓺蓻蓼蓽蓾蓿蔀蔁蔂蔃蔄蔅蔆蔈蔉蔊蔋蔌蔍蔎蔏蔐" +
+		"蔑蔒蔓蔔蔕蔖蔗蔘蔙蔚蔛蔜蔝蔞蔟蔠蔡蔢蔣蔤蔥蔦蔧蔨蔩蔪蔫蔬蔭蔮蔯蔰蔱蔲蔳蔴蔵蔶蔷蔸" +
+		"蔹蔺蔻蔼𦸀𦸅𦸇𦸒𦹂𦹃𦹄𦹅𦹮𦹲𦹷𦺄蔽蕏虢蝌蝎蝏蝐蝑蝒蝓蝔蝖蝗蝘蝙蝚蝛蝜蝝蝞蝟蝠蝡蝢" +
+		"蝣蝤蝥蝦蝧蝨蝩蝪蝬蝭蝮蝯蝰蝱蝲蝳蝴蝵蝶蝷蝸蝺蝻蝼蝽蝾蝿螀蟡𧎚螂衚衛衜衝𧗽䙅䙆裦褅" +
+		"褆複褈褉褊褋褌褍褎褏褐褑褒褓褔褕褖褗褘褙褛褜褝𧜏覢覣覤覥𧡘覩觐觑觬觭觮觯觰誕䛵誯" +
+		"誰誱課誳誴誵誶誷誸誹誺誻誼誽誾調諀諁諂諃諄諅諆談諈諉諊請諌諍諎諏諐諑諒諓諔諕論諗" +
+		"諘諙諚諩𧨾𧩓𧩙諛諸谳谴谵谾豌豍豎𧯴豬貎貏䝼賙賚賛賜賝賞賟賠賡賢賣賤賥賦賧賨賩質賫" +
+		"賬賭赜赭䞶趛趜趝趞趟趠趡趢趣趤䠀䠁䠋踏踐踑踒踓踔踕踖踗踘踙踚踛踜踝踞踟踠踡踢踣踤" +
+		"踥踦踧踨踩踪踬踭踮踯踺𨂃𨂐踫踷躶躷躸躹躺躻躼𨉖䡝輖輗輘輙輚輛輜輝輞輟輠輡輢輣輤輥" +
+		"輦輧輨輩輪輫輬𨌯𨌺辘辤辳遦遧遨適遪遫遬遭遮遯遰遱遳遷郶䣝鄦鄧鄩鄪鄫鄭鄮鄯鄰鄱鄲醀" +
+		"醁醂醃醄醅醆醇醈醉醊醋醌䤭銲銳銴銵銶銷銸銹銺銻銼銽銾銿鋀鋁鋂鋃鋄鋅鋆鋇鋈鋉鋊鋌鋍" +
+		"鋎鋏鋐鋑鋒鋓鋔鋕鋖鋗鋘鋙鋚鋛鋜鋝鋞鋟鋠鋡鋢鋣鋤鋥鋦鋧鋨鋩鋪鋫鋬鋭鋯鋰鋱鋲鋳鋴鋵鋶" +
+		"﨧𨦸𨦼𨧀𨧜𨧞𨧡𨧣𨧤镆镇镈镉镊镋镌镍镎镏镐镑镒镓镔镕镼閫閬閭閮閯閰閱閲閳閴𨴴䧥隢隣" +
+		"隤隥雓霂霃霄霅霆震霈霉霊𩃀靚靠靥鞇鞈鞉鞊鞋鞌鞍鞎鞏鞐鞑鞒韏韐韑韯𩐠頛頜頝頞頟頠頡" +
+		"頢頣頦頧頨頩頪頫頬题颙颚颛颜额颲颳飘䬷飺餈養餋餍餑餒餓餔餕餖餗餘餙馓馔駈駉駊駋駌" +
+		"駍駎駏駐駑駒駓駔駕駖駗駘駙駚駛駜駝駞駟駠𩢤骣骲骳骴骵骶骷髛髫髬髮髯髰髱髲髳髴鬧䰠" +
+		"魃魄魅魆䰻䰾魣魤魥魦魧魨魩魪魫魬魭魮魯魰魱魲魳魴魵魶魷魸魹𩵼鲠鲡鲢鲣鲤鲥鲦鲧鲨鲩" +
+		"鲪鲫鲬䲮䲰䲷鳷鳸鳹鳺鳻鳼鳽鳾鳿鴀鴁鴂鴃鴄鴅鴆鴇鴈鴉鴋鴌鴍鴎𩾷鹘鹝鹞鹟鹠鹡鹢鹣鹤鹶" +
+		"麃麄𪊓麨麩麪麫麹麾黎墨黓鼏鼐鼑齑齒龉龊",
+	// 16 strokes
+	"亸儐儑儒儓儔儕儖儗儘儙儚儛儜儝儞儫𠏵𠏼兣𠓼冀冪凝凞𠘕劐劑劒劓劔勳匴叡㗻㗾㘀㘁㘂㘃" +
+		"㘄噞噟噠噡噢噣噤噥噦噧器噩噪噫噬噭噮噯噰噱噲噳噵噶噷噸噹噺噻噼𠿟𠿪𠿫𠿬𠿭𡀝𡀞圛圜" +
+		"墺墻墼墽墾墿壀壁壂壃壄壅壆壇壈壉壊壋壌夁奮奯㜫㜬㜭嬐嬑嬒嬓嬔嬕嬖嬗嬘嬙嬚嬛嬜嬝嬞" +
+		"嬟嬠嬡嬢嬴𡢞𡢟𡢠𡢡𡢢𡢾𡢿嬨學孹寯寰嶦嶧嶨嶩嶪嶫嶬嶭嶮嶯嶰嶱嶲嶳嶴嶵嶶㡢㡣㡤幦幧幨" +
+		"𢅛幯廥廦廧廨廩廪彊彋彛彜𢑱𢒰徻徼憊憌憑憖憗憙憝憠憥憨憩憲㦙憴憶憷憸憹憺憽憾憿懀懁" +
+		"懄懅懆懈懊懌懍懎懏懐懒懓懔𢢭𢣁憻戱戰撉㩒㩔㩗撻撼撽撾撿擀擁擂擃擄擅擇擈擉擋擌操擏" +
+		"擐擑擒擓擔擕擖擗擙據擛擜擝擞𢶍𢶕𢶠𢶣𢶤𢶷擳攳整敼敽敾敿𢿣斓斢斴旘旙㬗㬘㬙㬚㬛㬜㬝" +
+		"㬞㬟暸暹暺暻暽暾暿曀曁曂曃曄曅曆曇曈曉曊曋曌曍𣊉𣊫𣊬𣊭曏㬱朆㬿朣朤朥樨橴㯗㯝樲樳" +
+		"樴樵樶樷樸樹樺樻樼樽樾樿橀橁橂橃橄橅橆橇橈橉橊橋橌橍橎橏橐橑橒橓橔橕橖橗橘橙橚橛" +
+		"橜橝橞機橠橡橢橣橤橦橧橨橩橪橫橬橭橮橯橰橱橲橳橵橶橷橸橹橺橻橼𣚦𣚭𣚺𣛟𣛮歔歕歖歗" +
+		"歘歙歚歷殧殨殩殪殫毇毈氃氄氅氆氇潞澃㵟㵢㵥㵩㵪澙澞澟澠澡澢澣澤澥澦澧澨澪澫澬澭澮" +
+		"澯澰澱澲澳澴澵澶澷澸澹澺澻澼澽澾澿激濁濂濃濄濅濇濈濉濊濋濍濎濏濑濒濓濖𣿅𣿫𣿬𣿭𣿮" +
+		"𣿯𣿰𤀑瀄㷳㷷㷼㷽熶熷熸熹熺熻熼熽熾熿燀燁燂燃燄燅燆燇燈燉燊燋燌燍燎燏燐燑燒燓燔燕" +
+		"燖燗燘燙燚燛燜燝燞𤎽𤏁𤏩𤏪𤏲犜犝犞犟獣獥獦獧獨獩獪獫獬獭瑿㻼璍璏璑璒璔璕璖璘璙璚" +
+		"璛璜璝璞璟璠璡璣璤𤩂𤩅𤩊𤩎𤩏𤩐𤩑𤩝𤩥𤩦𤩧璢瓢甊甋甌甍甎疀疁疂𤳉瘬瘭瘮瘯瘰瘱瘲瘳瘴" +
+		"瘵瘶瘷瘸瘹瘺瘻瘼瘽瘾瘿癊皟皠皡皻盥盦盧𥂝䁢䁥䁪瞔瞕瞖瞗瞘瞙瞚瞛瞜瞝瞞瞟瞠瞡瞢瞣𥊙" +
+		"瞥磖磜䃘磚磛磝磞磟磠磡磢磣磥磦磧磨磩磪磫磬磭磮𥕛𥕜𥕝𥕞𥕢𥕥𥕦禤禥禦禩𥛶穄穅穆穇穈" +
+		"穋穌積穎穏穐穑穒𥡝𥡲穓䆲窵窶窷窸窹窺窻窼窽竱𥪮䈪䈫䈭䈮䈰䈱䈲築篔篕篖篗篘篙篚篛篜" +
+		"篝篞篟篠篡篢篣篤篥篦篧篨篩篪篫篬篭篮篯簑𥰡𥱊𥱥篹䨀糏糐糑糒糓糔糕糖糗糘縈縉縊縋縌" +
+		"縍縎縏縐縑縒縓縔縕縖縗縘縙縚縛縜縝縞縟縠縡縢縣縤縥縦縧縨缰缱缲缳缴罃罹罺罻罼羱羲" +
+		"翮翯翰翱耨耩耪𦔒䏁聬聭𦖿聮膐䐻膦膧膨膩膪膫膬膭膮膯膰膱膲膳膴膵膶𦠜𦡮膷膹臲臻興舆" +
+		"舉舘艕艖艗艘艙䔀䔃䔄䔉䔋蓞蔾蔿蕀蕁蕂蕃蕄蕅蕆蕇蕈蕉蕊蕋蕌蕍蕎蕐蕑蕒蕓蕔蕕蕖蕘蕙蕚" +
+		"蕛蕜蕝蕞蕟蕠蕡蕢蕣蕤蕥蕦蕧蕨蕩蕪蕫蕬蕭蕮蕯蕰蕱蕲蕳蕴蕵𦺙𦻐𦻑𦻒𦻓𦻔𦻕𦻖𦻗𦼦薌虣虤" +
+		"虥虦䗝蝹螁螃螄螅螆螇螈螉螊螋螌融螎螏螐螑螒螓螔螕螖螗螘螙螚螛螜螝螞螟螠螡螢螣螤螥" +
+		"螦螧螨螩䘗衞衟衠衡𧗾䙏褞褟褠褡褢褣褤褥褦褧褨褩褪褫褬褭褮褯褰褱褲褴𧜵𧜶𧝁覦覧覨親" +
+		"𧡰觱諜諝諞諟諠諡諢諣諤諥諦諧諨諪諫諬諭諮諯諰諱諲諳諴諵諶諷諹諺諻諼諽諾諿謀謁謂謃" +
+		"𧩹𧪄謔䝎豫豭豮貐貑貒貓賮賯賰賱賲賳賴賵𧶽赝赞赟赠赬赮趥趦趧踰踱踲踳踴踵踶踸踹踻踼" +
+		"踽踾踿蹀蹁蹂蹃蹄蹅𨂽𨂾躽躾輭輮輯輰輱輲輳輴輵輶輷輸輹輺輻輼𨍥辙辚辥辦辧辨辩辪䢭遲" +
+		"遴遵遶選遹遺遻遼邆𨗨𨗴𨘀郺鄳鄴鄵鄶鄷䤀䤆醍醎醏醐醑醒醓醔醕醖醗鋋䤵鋷鋸鋹鋺鋻鋼鋽" +
+		"鋾鋿錀錁錂錃錄錅錆錇錈錉錊錋錌錍錎錏錐錑錒錓錔錕錖錗錘錙錚錛錜錝錞錟錠錡錢錣錤錥" +
+		"錦錧錩錪錫錬錭錮錯錰錱録錳錴錵錶錷錸錹錺錻錼錽錾錿鍀鍁鍂鍃鍄鍅鍆鍈﨨𨧧𨧨𨧹𨧺𨧻𨧼" +
+		"𨨏𨨖𨨥𨨩鍺镖镗镘镙镚镛镜镝镞镟镠䦡䦧閵閶閸閹閺閻閼閽閾閿闁闂闍阛䧧隦隧隨隩險隫隷" +
+		"雔雕䨝霋霌霍霎霏霐霑霒霓霔霕霖霗𩃤𩃥𩃬𩃭靛靜靦鞓鞔鞕鞖鞗鞘鞙韒韰韸頤頥頭頮頯頰頱" +
+		"頲頳頴頵頶頷頸頹頺頻頼頽𩓐𩓙𩓚颞颟颠颡颴颵𩗗飙飚餐餝餚餛餜餞餟餠餡餢餣餤餦餧館餩" +
+		"𩜠餴馞馟馠駡駢駣駤駥駦駧駨駩駪駫駬駭駮駯駰駱駲𩣑骸骹骺骻骼𩩍骿髭髵髶髷髸髹髺髻鬇" +
+		"鬨鬳魇䱉魺魻魼魽魾魿鮀鮁鮂鮃鮄鮅鮇鮈鮉鮊鮋鮌鮍鮎鮏鮐鮑鮒鮓鮔鮕鮖鮗鮘鮣𩶘𩶛鲭鲮鲯" +
+		"鲰鲱鲲鲳鲴鲵鲶鲷鲸鲹鲺鲻鴊鴏鴐鴑鴒鴓鴔鴕鴖鴗鴘鴙鴚鴛鴝鴞鴟鴠鴡鴢鴣鴤鴥鴦鴧鴨鴩鴪" +
+		"鴫鴬𩿞鹥鹦鹧鹨鹷鹾麅麆麇麈𪊟䴴麬麭麮麺黅黆黔黕黖黗默黺鼒鼼鼽齓龍龜",
+	// 17 strokes
+	"償儠儡儢儣儤儥儦儧儨儩優儬𠐓𠐔𠐟儲凟𠘙𠘚劕㔥㔦勴勵勶匵㕓厳𠮏噽噾噿嚀嚁嚂嚃嚄嚅嚆" +
+		"嚇嚈嚉嚊嚋嚌嚍嚎嚏嚐嚑嚒嚓𡁏𡁜𡁯𡁵𡁶𡁷𡁸𡁻𡂈㙺壍壎壏壐壑壒壓壔壕壖壗𡒊𡒗𡚒嬣嬤嬥" +
+		"嬦嬧嬩嬪嬫嬬嬭嬮嬯嬰嬱嬲嬳嬵嬶嬷𡣑𡣖𡣗𡣘𡣙孺孻寱寲尶尷屨㠙嶷嶸嶹嶺嶼嶽嶾嶿𡽪嶻㡥" +
+		"㡦幪幫幬彌徽徾𢖍憵憼懂懃懇應懋懑懗懙懚懛懜懝懞懠懡懢懤懥懦懧懨𢣷戲戴擊擎擘㩜㩞擟" +
+		"擠擡擢擣擤擦擨擩擫擬擭擮擯擰擱𢷮斀斁斂斃斣斵斶旚㬠㬡㬢曎曐曑曒曓曔曕曖曗曚𣋒曙㬲" +
+		"㭀㯬㯲㯳㯴橽橾橿檀檁檂檃檄檅檆檇檈檉檊檋檌檍檎檏檐檑檒檓檔檕檖檗檘檙檚檛檜檝檞檟" +
+		"檠檡檢檣檤檥檦檧檨檩檪𣜃𣜖𣜠𣜭𣜯𣜿櫛㱆歛歜歝殬殭殮毚氈氉氊澩濌㵯㵳㵵澀濔濕濗濘濙" +
+		"濚濛濜濝濞濟濠濡濢濣濤濥濦濧濨濩濪濫濬濭濮濯濰濱濲濴濵濶濸𣿀𤀹𤀺𤀻𤀼𤀽𤁗㸀㸁㸂營" +
+		"燠燡燢燣燤燥燦燧燨燩燪燫燬燭燮燯燰燱燲燳燴燵燶燷𤏸𤐄爵牆㹕犠獮獯獰獱獲獳獴㻺璐璗" +
+		"㻿㼀㼁璥璦璨璩璪璫璬璭璮璯環璱璲璳璴𤩱𤩷𤩸𤩹𤩺㼿甏甐甑甒疃疄𤳙癀癁療癃癄癅癆癇癈" +
+		"癉癋癌癍癎𤺥𤺧皢皣皤皥皼䀉盨盩盪䁯䁱瞤瞦瞧瞨瞩瞪瞫瞬瞭瞮瞯瞰瞱瞲瞳瞴瞵瞶瞷𥋇矯矰" +
+		"䃟磯磰磱磲磳磴磵磶磷磸磹磺磻磼磽磾磿礀礁礂礃礄礅𥖁𥖄𥖏禧禨禪禫𥜆䅿穉穔穕穖穗穘穙" +
+		"穚穛穜穝穞䆹窾窿竀竁竂竲竳竴𥪯簕䈻䉀䉁䉂䉃䉄䉅篰篱篲篳篴篵篶篷篸篺篻篼篽篾篿簀簁" +
+		"簂簃簄簅簆簇簈簉簊簋簌簍簎簏簐簒簓簔簖簗𥲑𥲤𥳀簘䊢糙糚糛糜糝糞糟糠糡糢糨縩縪縫縬" +
+		"縭縮縯縰縱縲縳縴縵縶縷縸縹縺縻縼總績縿繀繁繂繃繄繅繆繇繉繊繌繍𦄂𦄡繈罄罅罆罽罾罿" +
+		"羁𦎾翲翳翴翵翶翼𦒄𦒈𦒉耫耬聯聰聱聲聳聴𦘦膥膸膺膻膼膽膾膿臀臁臂臃臄臅臆臇臈臉臊臌" +
+		"𦡆𦡞臨臩𦧲艚艛艜艝艱䔖䔝䔠䔦䔧蕗蕶蕷蕸蕹蕺蕻蕼蕽蕾蕿薀薁薂薃薄薅薆薇薈薉薊薋薍薎" +
+		"薏薐薑薒薓薔薕薖薗薘薙薚薛薜薝薞薟薠薡薢薣薤薥薦薧薨薪薫薬薮𦽳𦽴𦾟𦾡薭薯虧虨䗩䗮" +
+		"螪螫螬螭螮螯螰螱螲螳螴螵螶螷螸螹螺螻螼螽螾螿蟀蟁蟂蟃蟄蟅蟆蟇蟈蟉蟊蟋蟌蟍蟎蟏蟐蟑" +
+		"蟒𧐢蟞䙛褳褵褶褷褸褹褺褻褼褽褾褿襀襁襂襃襄襅襔襒𧝞覫覬覭覮覯觲觳𧤤䜀謄謅謆謇謈謉" +
+		"謊謋謌謍謎謏謐謑謒謓謕謖謗謘謙謚講謜謝謞謟謠謡謢𧪽𧪾䜦谿豀豁豏豯豰豱豲豳貔貕貖賶" +
+		"賷賸賹賺賻購賽𧷜赡赢赯趨蹆蹇蹈蹉蹊蹋蹌蹍蹎蹏蹐蹑蹒蹓𨃨𨃩𨃴輽輾輿轀轁轂轃轄轅𨍭𨍽" +
+		"辫䢮遽遾避邀邁邂邃還邅邉𨘋鄸鄹醘醙醚醛醜醝醞醟醠醡醢醣醤𨤳䤼錨鍇鍉鍊鍋鍌鍍鍎鍏鍐" +
+		"鍑鍒鍓鍔鍕鍖鍗鍘鍙鍚鍛鍜鍝鍞鍟鍠鍡鍢鍣鍤鍥鍦鍧鍨鍩鍪鍫鍬鍭鍮鍯鍰鍱鍲鍳鍴鍵鍶鍷鍸" +
+		"鍹鍻鍼鍽鍾鍿鎀鎁鎂鎃鎄鎅鎆鎇𨨲𨨶𨩄𨩅𨩆𨩇𨩈𨩉𨩊𨩋𨩙𨩚𨪁𨪂𨪃鎡鎯镡镢镣镤镥镦镧镨镩" +
+		"镪镫闀閷闃闄闅闆闇闈闉闊闋闌闎闏隬隭隮隯隰隱隲隸䨁䨂雖䨤霘霙霚霛霜霝霞霟霠𩄍𩄐霡" +
+		"䩊鞚鞛鞜鞝鞞鞟鞠鞡韓韔韕韱䫑顀顁顂顃顄顅顆顇顈顉顊𩓥𩓧䬐颶颷𩗩𩗴䬠餥餪餫餬餭餯餰" +
+		"餱餲餳餵餷𩜲饂饆馘䭰䭲馡馢馣䮎䮐駴駵駶駷駸駹駺駻駼駽駾駿騀騁騂騃𩣪駳骤骽骾髼髽髾" +
+		"髿鬀鬁鬂鬴魈魉鮆䱋䱌䱍鮙鮚鮛鮜鮝鮞鮟鮠鮡鮢鮤鮥鮦鮧鮨鮩鮪鮫鮬鮭鮮鮯鮰鮱鮲鮳鮴鮺鯎" +
+		"鲼鲽鲿鳀鳁鳂鳃鳄鳅鳆鳇鳈鳉鳊鳋鲾鴜䳍䳔鴭鴮鴯鴰鴱鴲鴳鴴鴵鴶鴷鴸鴹鴺鴻鴼鴽鴾鴿鵀鵁" +
+		"鵂鵃鵄鵅鵆鵇鵈鵉𪀔鵧鹩鹪鹫鹬麉麊麋𪊲麯麰黇黈黉黏黚黛黜黝點𪐴黻黿鼢鼣鼤鼾鼿齋𪗆齔" +
+		"齢龋龌龠",
+	// 18 strokes
+	"儭儮儯儱㒯𠓾冁𠖥𠫂叢㘉嚔嚕嚖嚗嚘嚙嚚嚛嚜嚝嚞嚟嚠嚡嚢嚣嚤𡂖𡂝𡂴𡂿𡃀𡃁𡃇𡃈𡃉𡃏𡃓嚮" +
+		"壘壙𡒶夑夓奰㜰㜱嬸嬺嬻嬼𡣺屩屪巀巁巂幭幮廫彍彝彞㦛懕懖懘懟懣㦡懩懪懫懭懮懰懱懳懴" +
+		"戳擧擪㩡㩦㩧擥擲擴擵擶擷擸擹擺擻擼擽擾擿攁攂攃攄攅攆𢸍㪫贁𣁦斔𣂎斷旛㬣㬤㬥㬦㬧㬨" +
+		"曘曛曜𣋠𣋡朦檫檬檭檮檯檰檱檲檳檴檵檶檷檸檹檺檻檼檽檾檿櫀櫁櫂櫃櫄櫅櫆櫇櫈櫉櫊𣝦𣞁" +
+		"櫡櫭歞歟歸殯毉氋濷㵽濹濺濻濼濽濾濿瀀瀁瀂瀃瀅瀆瀇瀈瀉瀊瀋瀌瀍瀎瀏瀐瀑瀒瀓瀔𤂅𤂋𤂌" +
+		"𤂍𤂑瀦㸄燸燹燺燻燼燽燾燿爀爁爃𤐵𤐶𦦨獵獶獷璧璵璶璸璹璻璼璾璿瓀瓁瓂𤪌𤪓𤪔𤪕𤪖𤪤𤪥" +
+		"𤪦𤪧甓甔甕疅癏癐癑癒癓癔癕癖癗癘癙癚癛癜癝癞癤皦皧皨𤾩㿹皽盫盬瞸瞹瞺瞻瞼瞽瞾瞿矀" +
+		"矁矂𥋘礆礇礈礉礊礋礌礍礎礏礐礑礒礓礔礕礖䄠禬禭禮禯穟穠穡穢穣𥣈竄竅竵䉎䉕簙簚簛簜" +
+		"簝簞簟簠簡簢簣簤簥簦簧簨簩簪簫簭簮簯簰簱簲𥳁𥳾𥴠䊦糣糤糥糦糧𥼚䌘繎繏繐繑繒繓織繕" +
+		"繖繗繘繙繚繛繜繝繞繟繠繡繢繣繤繥繧繱𦅙𦅚𦅛𦅜罇罈罉𦉘羀羂羳羴羵䎗翷翸翹翺翻𦒍𦒘耭" +
+		"耮聵聶職䑃䑄䑅臍臎臏臐臑臒臓𦢈舊舙艞艟艠䒏䔮䔳䔻䔽䔿䕀䕃䕄薩薰薱薲薳薴薵薶薷薸薹" +
+		"薺薻薼薽薾薿藀藁藂藃藄藅藆藇藈藉藊藋藌藍藎藏藐藒藓𦾾𦿞𦿟𧀎䖛虩蟗蟓蟔蟖蟘蟙蟚蟛蟜" +
+		"蟝蟟蟠蟢蟣蟤蟥蟦蟧蟨蟩蟪蟫蟬蟭蟮蟯蟰蟱蟲蟳蟴蟵蠎𧑐𧒄𧒆襆襇襈襉襊襋襌襍襎襏襐襑襓" +
+		"襕𧞄𧞅覆䚍覰覱覲観觴鵤謣謤謥謦謧謨謩謪謫謬謭謮謯謰謱謲謳謴謵謶謷謸謹謺謻謼謽謾𧫴" +
+		"譇豂豐豴豵貗貘貙賾賿贀贂贃贄贅趩䠠蹔蹕蹖蹗蹘蹙蹚蹛蹜蹝蹞蹟蹠蹡蹢蹣蹤蹥蹦蹧蹮躀𨄮" +
+		"蹩躿軀軁𨉼䡱轆轇轈轉轊轋轌𨎊辬邇邈𨘥鄨鄺鄻鄼鄽鄾醥醦醧醨醩醪醫醬釐䤾䥄䥅䥇鎈鎉鎊" +
+		"鎋鎌鎍鎎鎏鎐鎑鎒鎓鎔鎕鎖鎗鎘鎙鎚鎛鎜鎝鎞鎟鎠鎢鎣鎤鎥鎦鎧鎨鎪鎫鎬鎭鎮鎰鎱鎲鎳鎴鎵" +
+		"鎶鎷鎸鎹鎺鎻鎼鎽鎾鎿𨪚𨪛𨪜𨫀𨫆𨫋𨫌𨫎镬镭镮镯镰镱闐闑闒闓闔闕闖闗闘𨶙隳䨃雗雘雙雚" +
+		"雛雜雝雞雟雠離䨦霢霣霤霥靝鞢鞣鞤鞥鞦鞧鞨鞩鞪鞫鞬鞭鞮鞯鞰䪖韖韗韘韙韚韹韺𩐳頿頾顋" +
+		"題額顎顏顐顑顒顓顔顕颢颣颸颹颺䭉䭋䭌餮餶餸餹餺餻餼餽餾餿饀饁馤馥䮓䮖䮗騄騅騆騇騈" +
+		"騉騊騋騌騍騎騏騐騑騒験𩣱𩤃𩤅髀髁髜䰀䰁鬃鬄鬅鬆鬈鬩鬵鬶䰦魊魋魌魍魎魏鮵鮶鮷鮸鮹鮻" +
+		"鮼鮽鮾鮿鯀鯁鯂鯃鯄鯆鯇鯈鯉鯊鯋鯌鯍鯏鯐鯑鯒鯓鯽𩷶鳌鳍鳎鳏鳐鳑鳒鵊鵋鵌鵍鵎鵏鵐鵑鵒" +
+		"鵓鵔鵕鵖鵗鵘鵙鵚鵛鵜鵝鵞鵟鵠鵢鵣鵥鹭鹮鹯鹰䴦麌麍麎麏麐𪊴𪊶𪊺𪊽䴶麱麲麿黊黋黟黠黡" +
+		"鼀鼁鼂鼕鼖鼥鼦鼧鼨鼩鼪鼫鼬齌齕龎",
+	// 19 strokes
+	"㐦㒣儳儴儵劖勷勸匶厴壡嚥嚦嚧嚨嚩嚪嚫嚬嚭嚯嚰𡃤𡃴𡃵𡃶壚壛壜壝壞壟壠壢夒嬽㜲㜳㜴㜵" +
+		"嬹嬾嬿𡤃𡤄𡤅孼寳寴寵屫㠠㠢巃巄巅𡾞𡾡幰𢅳廬廭龐彟徿懬懯懲懵懶懷𢤦𢤹懻攀攇攈攉攊攋" +
+		"攌攍攎攏攐攒𢸶𢹂斄旜旝旞㬩㬪曝曞曟曠曡曢㰀㰁㰂㰄櫋櫌櫍櫎櫏櫐櫑櫒櫓櫔櫕櫖櫗櫘櫙櫚" +
+		"櫜櫝櫞櫟櫠櫢櫣櫤櫥櫦櫫𣞢𣞼𣟂櫧歠殰殱𣫛氌㶅㶊濳瀕瀖瀗瀘瀙瀚瀛瀜瀝瀞瀟瀠瀡瀢瀣瀤瀥" +
+		"瀧瀨瀩瀫瀬瀭瀮𤃉𤃡爂㸆爄爅爆爇爈爉爊爌爍爎爕𤑚𤑛牘犡犢犣犤犥犦獸獹獺璷璽㼄㼆瓃瓄" +
+		"瓅瓆瓇瓈瓉瓊瓋𤪱𤪲𤪳𤪺𤪻𤪼瓣甖疆疇癟癠癡癣皩𥀬矃矄矅矆矇矈矉矊𥌎𥌑𥌓矱礗礘礙礚礛" +
+		"礜礝礞礟礠礡𥖹禰禱𥜝穤穥穦穧穨穩穪穫𥣡竆簬䉏䉠簳簴簵簶簷簸簹簺簻簼簽簾簿籀籁籂𥴰" +
+		"𥵃糩糪糫糬糭𥽋䌠繋繦繨繩繪繫繬繭繮繯繰繲繳繴繵繶繷繸繹繺缵罊罋羃羄羅羆羶羷羸羹翽" +
+		"翾聸臋䑆臔臕臗臘𦢊𦢓𦤦舋舚艡艢艣艤艥艶䕅䕆䕑﨟藑藕藖藗藘藙藚藛藜藝藞藟藠藡藢藣藤" +
+		"藥藦藧藨藩藪藫藬藭藯藰藱藲藳藴藵𧁋𧁒𧁓藷藸蠁蟕蟶蟷蟸蟹蟺蟻蟼蟽蟾蟿蠀蠂蠃蠄蠅蠆蠇" +
+		"蠈蠉蠊蠋蠌蠍蠏蠞襖襗襘襙襚襛襜襝襞襟襠襡襢覇覈覴覵覶覷覸觵觶謿譀譁譂譃譄譆譈證譊" +
+		"譋譌譎譏譐譑譒譓譔譕譖譗識譙譚譛譜𧬆𧬋𧬘谶豃豷豶貚贆贇贈贉贊贋贌趪趫趬趭䠦蹨蹪蹫" +
+		"蹬蹭蹯蹰蹱蹲蹳蹴蹵蹶蹷蹸蹹蹺蹻蹼蹽蹾蹿𨅏𨅝𨅯躇軂軃軄軅轍轎轏轐轑轒轓轔辭辴邊邋邌" +
+		"𨘻鄿酀酂䤑醭醮醯醰醱䥉䥑䥓鎩鏀鏁鏂鏃鏄鏅鏆鏇鏈鏉鏊鏋鏌鏍鏎鏏鏐鏑鏒鏓鏔鏕鏖鏗鏘鏙" +
+		"鏚鏛鏜鏝鏞鏟鏠鏡鏢鏣鏤鏥鏦鏧鏨鏩鏪鏫鏬鏭鏮鏯鏰鏱鏲鏹𨫞𨫟𨫠𨫡𨫢𨫣𨫥𨫪𨫼𨬌镲镽闙闚" +
+		"闛關闝隴䨄雡難霦霧霨霩霪霫霬霭𩄼𩅍𩅛靡鞱鞲鞳鞴鞵鞶鞷韜韝韞韟韲韻韼䫤顖顗願顙顚顛" +
+		"顜顝類颤䬙颻颼颽颾颿飀䭓饃饄饅饇饈饉馦馧𩡗䮝騔騕騖騗騘騙騚騛騜騝騞騟騠騡騢騣騤騥" +
+		"騦騧騨𩤯骥髂髃髅䰄䰇鬉鬊鬋鬌鬍鬎鬏鬷鯅䱛鯔鯕鯖鯗鯘鯙鯚鯛鯜鯝鯞鯟鯠鯡鯢鯣鯤鯥鯦鯧" +
+		"鯨鯩鯪鯫鯬鯭鯮鯯鯰鯱鯲鯳鯴鯵𩸆𩸭鯺鳓鳔鳕鳖鳗鳘鳙鳚鳛鵡䳡鵦鵨鵩鵪鵫鵬鵭鵮鵯鵰鵱鵲" +
+		"鵳鵴鵵鵶鵷鵸鵹鵺鵻鵼鵽鵾鵿鶀鶁鶂鶃鶄鶅鶆鶇鶈鶉鶊鶋鶌鶍鶎鶏鶑𪂇鹱鹲鹸麑麒麓麔麕麖" +
+		"麗麳麴黀䵌黢黣黼鼃鼄鼗鼭齀齁齍齖齗齘龏𪚩",
+	// 20 strokes
+	"㒥儶匷嚱嚲嚳嚴嚵嚶嚷嚸嚹𡄯嚼壣壤壥𡓨㜶㜷㜸孀孁孂孃孄孅孆𡤐𡤑𡤒𡤕孽孾寶巆巇巈巉巊" +
+		"巌幱𢅺廮廯廰忀忁㦤懸懹懺𢥏㩰攓攔攕攖攗攘攙攚斅斆旟㬫曣曤曥曦曧曨𣌀朧㰉㰊㰍㰑櫨櫩" +
+		"櫪櫬櫮櫯櫰櫱櫲櫳櫴櫵櫶𣟕𣟖𣟗櫹瀪㶏㶑瀯瀰瀱瀲瀳瀴瀵瀶瀷瀸瀹瀺瀻瀼瀽瀾瀿灀灁𤄄灂㸊" +
+		"爋爏爐爑爒爓爔爖爗爘𤑳𤒇𤒈犧犨𤜆獻獼獽璺瓌瓍瓎瓏瓐瓑瓒𤫀𤫇疈疉癢癥癦皪皫㿺皾盭矋" +
+		"矌矍矎矏矲礢礣礤礥礦礧礨礩礪礫礬禲穬穭穮穯竇競竷籃籄籅籆籇籈籉籊籋籌籍籎籏籕䊮糮" +
+		"糯糰䌦繻繼繽繾繿纀纁纂纃𦆭𦆮𦆲罌𦌵羺翿耀耯聹聺聻聼臖臙臚臛臜𦦵艦艧艨艩䕒䕔䕕䕗䕜" +
+		"蘤藮藶藹藺藻藼藽藾藿蘀蘁蘂蘃蘄蘅蘆蘇蘈蘉蘊蘋蘌蘍蘎蘏蘐蘑蘓蘔蘢𧂈𧂭𧂮𧂯蘒蘛蘰䘀䘁" +
+		"蠐蠑蠒蠓蠔蠕蠖蠗蠘蠙襣襤襥襦襧襨覹覺覻觷觸觹䜓䜘譍譝譞譟譠譡譢譣譤譥警譧譨譩譪譫" +
+		"譬譭譮譯議譱譲𧬸𧬹𧬺豑𧰒贍贎贏趮躁躂躃躄躅躆躈躉𨆉軆轕轖轗轘轙轚辮邍酁酃醲醳醴醵" +
+		"醶醷醸釋鏳鏵鏶鏷鏸鏺鏻鏼鏽鏾鏿鐀鐁鐂鐃鐄鐅鐆鐇鐈鐉鐊鐋鐌鐍鐎鐏鐐鐑鐒鐓鐔鐕鐖鐗鐘" +
+		"鐙鐚鐛鐜鐝鐞鐟鐠鐡鐢鐣鐤鐥鐦鐧鐨𨬓𨬡𨬢𨬫𨬬𨬭𨬯𨭆𨭌𨭎𨭐鐯鐼镳镴闞闟闠闡𨶹隵霮霯霰" +
+		"霱霳霴𩅞𩅰䩋鞸鞹鞺鞻韛韠韽韾響顟顠顡顢顣颥飁飂飃飄饊饋饌饍饎饐饑饒饓饙馨騩騪騫騬" +
+		"騭騮騯騰騱騲騳騴騵騶騷騸𩥇𩥈𩥉𩥝𩥪骦骧髄髆髇髈髉髊髋髌鬐鬑鬒鬓鬪鬸魐鯻䱭鯶鯷鯸鯹" +
+		"鯼鯾鯿鰀鰁鰂鰃鰄鰅鰆鰇鰈鰉鰊鰋鰌鰍鰎鰏鰐鰑鰒鰓鰔鰕鰖鰗鰘鰙鰚鰛鰠𩹨鱀鳜鳝鳞鳟䳭鶐" +
+		"鶒鶓鶔鶕鶖鶗鶘鶙鶚鶛鶜鶝鶞鶟鶠鶡鶢鶣鶤鶥鶦鶧鶨鶩鶪鶫𪂹𪃡𪃭𪃳𪃸鶿鹹麘麙麚麛麵黁𪎩" +
+		"䵍黤黥黦黧黨黩黪𪑛鼍鼮鼯鼰𪗋齙齚齛齝齞齟齠齡齣龑",
+	// 21 strokes
+	"㒧儷儸儹儺兤劗劘𠠬卛嚺嚻嚽嚾嚿囀囁囂囃囄囍𡄻𡄽𡅅𡅈𡅏壦𡓽夔㜹孇孈孉𡤜寷屬巋㠦巍巏" +
+		"巐廱忂懼懽懾攑攛攜攝𢹸斕曩𣌊朇㰕櫸櫺櫻櫼櫽櫾櫿欀欁欂欃欄欅欌殲灃灄灅灆灇灈灉灊灋" +
+		"灌灍灏灐𤄏𤄙㸍爙爚爛𤒹爝獾瓓瓔瓖𤫊𤫑甗㿗癧癨癩癪癫皬𤾸矐矑矒矓礭礮礯礰礱礲礳礴𥗕" +
+		"𥗛𥜥𥤃竃竈竉籖䉪籐籑籒籓籔糲纄纅纆纇纈纉纊纋續纍纎纏纐罍羻羼耰臝艪䕢藔蘕蘖蘗蘘蘙" +
+		"蘚蘜蘝蘞蘟蘠蘡蘣蘥蘦蘧蘨蘩蘪蘫蘭蘮蘯𧃍𧃸𧄉𧄌䘂蠚蠛蠜蠝蠟蠠蠡蠢蠣蠤蠩蠫衊襩襪襫襬" +
+		"襭襮覼覽觺譅譳譴譵譶護譸譹譺譻譼譽𧭈贐贑贒贓贔赣趯趰躊躋躌躍躎躏𨆯𨆼軇轛轜轝轞轟" +
+		"辯邎酄酅酆醹醺醻䥥鏴鐩鐪鐫鐬鐭鐮鐰鐱鐲鐳鐴鐵鐶鐷鐸鐹鐺鐻鐽鐾鐿鑀鑁𨭣𨭤𨭥𨭦𨭬𨮏闢" +
+		"闣闤闥闦雤露霵霶霷霸霹霺霻靧鞼鞽鞾鞿韡韢𩐿顤顥顦顧顨颦飅飆飇飈飉飊飜饏饖饗饘馩騹" +
+		"騺騻騼騽騾騿驀驁驂驃驄驅驆驇髍髎髏鬔鬕鬖鬗鬘鬹鬺魑魒魓魔䱽鰜鰝鰞鰟鰡鰢鰣鰤鰥鰦鰧" +
+		"鰨鰩鰪鰫鰬鰭鰮鰯鰰𩺬䲣䲤鳠鳡鳢鳣鶬鶭鶮鶯鶰鶱鶲鶳鶴鶵鶶鶷鶸鶹鶺鶻鶼鶽鶾鷀鷁鷂鷃鷄" +
+		"鷅鷆鷇鷈鷉鷊鷌鷍鷎鷏𪃾𪄇𪄣鹺鹻麜麝䵎黫黬黭黮黯鼅鼘鼙鼚鼛鼱齎齜齤齥齦齧齨齩𪘁龒龝" +
+		"龡",
+	// 22 strokes
+	"亹儻儼𠑥𠥹㘘囅囆囇囈囉囊囋囎圝奱㜺孊孋孌𡤢𡤧孿巎巑巒巓巔巕巗廲彎彲懿戂𢥧𢥫戵攞攟" +
+		"攠攡攢攤攦攧𢺋𣀳㬬㬭㰘櫷欆欇欈欉權欋欍欎歡氍灑灒灔灕灖灗灘𤄿𤅀𤅄爜爞爟爠犩獿玀瓕" +
+		"瓗瓘瓙瓤疊癬癭癮𤼎皭礵𥗠禳禴穰穱竊竸籗籘籙籚籛籜籝籟籠籡糱糴䌫纑纒𦇝罎罏𦉡羇耱耲" +
+		"聽聾臞臟𦧺艫䕧䕪蘬蘲蘳蘴蘵蘶蘷𧄍𧄦𧄧䘆蠥蠦蠧蠨蠪蠬襯襰襱襲覾覿𧢝觻觼䜠譾譿讀讁讂" +
+		"讃讄讅讆豄贕贖贗贘躐躑躒躓躔躕躖躗躚轠轡轢酇酈䥪䥭鑂鑃鑄鑅鑆鑇鑈鑉鑊鑋鑌鑍鑎鑏鑐" +
+		"鑑鑒鑓鑔鑧𨮙𨮜𨮝镵镶镾闧霼霽霾霿靀𩆜韀韁韂韃韣顩顪顫飋饔饕饚饛𩟔驈驉驊驋驌驍驎驏" +
+		"驐驑驒驓驔驕𩦝髐髒髝鬝䰎鬙鬚鬛鬜𩯕鬫鬻魕魖䲁鰱鰲鰳鰴鰵鰶鰷鰸鰹鰺鰻鰼鰽鰾鰿鱁鱂鱃" +
+		"鱄鱅鱆鱇鱈鷠𩻃鱉鳤鷋鷐鷑鷒鷓鷔鷕鷖鷗鷘鷙鷚鷛鷜鷝鷞鷟𪄳𪄴𪅐鷩鷵鹳鹴麞𪋟麶黐黰黱鼲" +
+		"鼳鼴鼵齂䶜齪齫齬龓龔龕龢",
+	// 23 strokes
+	"儽劙劚𠫍㘚囌囏囐壧壨𡖂奲孍巖巘巚彏戀戁戃戄攣㩷攥攨攩攪攫斖㬮曪曫曬欏欐欑欒𣠺毊灓" +
+		"灙灚灛灜𤅎𤅕𤅖𤅗𤅜𤅟爡爢𤒼𤓎𤓓𤓖玁玂玃瓚𤫟癯癰矔礶礷禵籞䉴籢籣籤籥籦籧籨糵纓纔纕" +
+		"纖臢𦣇艬䕷蘱蘸蘹蘺蘻蘼蘽蘾蘿虀虁𧈛蠴蠭蠮蠯蠰蠱蠲蠳襳襴襶覉觽觾讇讈讉變讋讌讍讎讏" +
+		"讐豅贙贚趱躘躙躛躜𨊛轣轤邏邐醼䥲鑕鑖鑗鑘鑙鑚鑛鑜鑝鑞鑟鑠鑡鑢鑣鑤鑥鑦𨯂𨯅𨯔𨯗𨯙𨯚" +
+		"䨵靁𩆨靨韄韅頀顬顭顮顯颧饜馪驖驗驘驙驚驛驜髑髓體髞鬞鬟鬠鱊鱋鱌鱍鱎鱏鱐鱑鱒鱓鱔鱕" +
+		"鱖鱗鱘鱙鱚鱛𩻸鱪䴀鷡鷢鷣鷤鷥鷦鷧鷨鷪鷫鷬鷭鷮鷯鷰鷱鷲鷳鷴鷶鷷鷸鷻鷼𪆒𪆓𪆫麟黂黲黳" +
+		"黴鼆鼇鼜鼶鼷鼸鼹齃齄齏齭齮齯齰齱𪘲",
+	// 24 strokes
+	"儾𠓗囑囒囓𡆀㚁壩孎孏屭巙𢦀攬攭曭曮欓欔欕灝灞灟灠灡爣瓛瓥癱癲𤿂矕矗矖䃺礸禶禷穳穲" +
+		"䉶籪纗罐羈羉艭艷虃虅𧅤𧅥蠵蠶蠷蠸蠹蠺衋衢襵襷𧟌讑讒讓讔讕讖贛躝躞躟躠軈醽醾醿釀釂" +
+		"鑨鑩鑪鑫鑬𨯧𨯨𨯩𨯪𨯫𨯬𨯵雥雦靂靃靄靅靆靇靈韆韇韈韤韥𩑈顰饝驝驞驟髕鬡鬢鬬鬭魗魘魙" +
+		"𩴾鱜鱝鱞鱟鱠鱡鱢鱣鱤鱥鱦鱧鱩鱫𩼣鱰鷺䴉鷹鷽鷾鷿鸀鸁鸂鸃鸄鸅鸆鸇鸈鸉鸊𪆴鹼鹽麠鼞齅" +
+		"齆齲齳齴齵齶齷",
+	// 25 strokes
+	"囔囕𡆇壪廳戅戆攮斸㬯曯欖欗欘欙欚欛欝灢灣爤爥爦犪𤴆矘矙矡礹籩籫籬籭籮糶纘纙纚纛臠" +
+		"臡虂虆虇虈虉蠻𧕴襸襹襺襻襼覊觀觿讗讘讙豒貛贜𧹍躡躢躣躤躥釁鑭鑮鑯鑰鑱鑲鑳𨯿𨰃靉顱" +
+		"顲饞饟馕䮽𩧃𩧉髖鬣鱨鱬鱭鱮鱯𩼰鸋鸌鸍鸎鸏鸐鸑鸒𪇟麡黌黵鼈鼉鼝鼟齇齸齹齺齻𪙊龣",
+	// 26 strokes
+	"㔶圞㜻彠欜氎灎灤灦𤫢癳矚籯籰𥸎糳虄虪蠼讚讛𧹏趲躦躧釃釄鑴鑵鑶鑷鑸鑹鑺𨰉𨰜𨰝靊韉䮾" +
+		"驠驡驢驣驥髗鱱鱲鱳鱴鱵鱶鸓鸔𪇵黶鼊𪙛龤龥",
+	// 27 strokes
+	"灥灧灨𤅷𤅺犫糷纜纝虊蠽蠾蠿襽讜讝讞豓貜躩躪軉轥釅鑻鑼鑽鑾𨰣𨰦靋靌靍靎顳顴飌飍飝饠" +
+		"饡馫驤驦驧鬤鬮鬰鱷鱸鸕鸖鸗黷齈",
+	// 28 strokes
+	"囖戇𢺳𣌟欞欟爧𤫩㿜癴𧅵虌豔躨𨈇鑿钀钁钂𨰫𨰰雧䯀驨驩鸘鸙鸚𪈠麢黸鼺齼齽龞",
+	// 29 strokes
+	"爨纞虋讟䥹钃钄靏驪鬱鱹鸛鸜麷",
+	// 30 strokes
+	"厵癵䆐籱䖅𨰹韊饢驫𩱳鱺鸝鸞𪈳䶑",
+	// 31 strokes
+	"灩𧖣䴐麣",
+	// 32 strokes
+	"灪籲𨰻龖",
+	// 33 strokes
+	"𡤻爩鱻麤龗",
+	// 34 strokes
+	"",
+	// 35 strokes
+	"齾",
+	// 36 strokes
+	"齉",
+	// 37 strokes
+	"",
+	// 38 strokes
+	"",
+	// 39 strokes
+	"靐",
+	// 40 strokes
+	"",
+	// 41 strokes
+	"",
+	// 42 strokes
+	"",
+	// 43 strokes
+	"",
+	// 44 strokes
+	"",
+	// 45 strokes
+	"",
+	// 46 strokes
+	"",
+	// 47 strokes
+	"",
+	// 48 strokes
+	"龘",
+}
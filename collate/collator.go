@@ -0,0 +1,613 @@
+// Package collate implements a configurable Collator, which compares strings
+// in natural order like sortorder.NaturalCompare, with options for Unicode
+// digits, case, accents, white space, alphabets of languages and more.
+//
+// This is a separate sub-package because it pulls in the Unicode tables in
+// the standard library and the normalization tables of golang.org/x/text,
+// which can add significantly to the size of binaries.
+package collate // import "github.com/fvbommel/sortorder/collate"
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+	"unicode"
+
+	"github.com/fvbommel/sortorder"
+	"github.com/fvbommel/sortorder/internal/rank"
+)
+
+// A Collator compares strings in natural order, like
+// sortorder.NaturalCompare, but with configurable behavior.
+//
+// A Collator is immutable, so it is safe for concurrent use.
+// The zero value (and NewCollator without options) compares exactly
+// like sortorder.NaturalCompare, using the same fast implementation.
+type Collator struct {
+	unicodeDigits bool
+	fractions     bool
+	signed        bool
+	ignoreCase    bool
+	zeros         ZeroPolicy
+	normalization Normalization
+	ignoreAccents bool
+	strength      Level
+	caseOrder     CaseOrder
+	// classRanks holds the rank of each CharClass plus one, or all zeros
+	// for the default order.
+	classRanks  [numClasses]uint8
+	space       spaceMode
+	ignorePunct bool
+	// runes maps characters to equivalent ones, if not nil.
+	runes *runeMap
+	// tailoring holds the tailored order of characters, if not nil.
+	tailoring *tailoring
+	// scripts holds the scripts ordered by ScriptOrder, if not nil.
+	scripts *scriptOrder
+}
+
+// A runeMap maps characters to the lowest character they are equivalent to,
+// or to ignoredRune if they are ignored.
+type runeMap struct {
+	m map[rune]rune
+}
+
+// ignoredRune marks characters ignored with IgnoreRunes.
+const ignoredRune = -1
+
+// runeMap returns c's runeMap, creating it if needed.
+func (c *Collator) runeMap() map[rune]rune {
+	if c.runes == nil {
+		c.runes = &runeMap{m: make(map[rune]rune)}
+	}
+	return c.runes.m
+}
+
+// An Option configures a Collator. See NewCollator.
+// The zero Option has no effect.
+type Option struct {
+	apply func(*Collator)
+}
+
+// UnicodeDigits makes a Collator treat all decimal digits
+// (Unicode category Nd) as digits, not just ASCII '0' through '9'.
+// For example, fullwidth "ファイル２" < "ファイル１０" and
+// Arabic-Indic "ملف٢" < "ملف١٠".
+//
+// Digits are compared by their numeric value, regardless of their script.
+// If two strings are otherwise equal, the first number whose digits are
+// written differently decides, comparing digits by code point.
+// For example, "file2" < "file２" because '2' < '２'.
+var UnicodeDigits = Option{func(c *Collator) { c.unicodeDigits = true }}
+
+// DecimalFractions makes a Collator treat a number followed by a decimal
+// point ('.') and more digits as a single decimal number. The fractional
+// parts are compared digit by digit, so e.g. "sample_0.25mm" <
+// "sample_0.3mm" < "sample_1mm".
+//
+// Numbers that are part of a sequence with several dots, like the version
+// number "1.10.2", are compared as separate integers instead. Such a version
+// number sorts after all decimal numbers with the same integer part, so e.g.
+// "1.2" < "1.25" < "1.9" < "1.2.3" < "1.10.2" < "2".
+// If two numbers are equal except for trailing zeros after the decimal
+// point, the one with fewer of them is less, so e.g. "0.5" < "0.50".
+var DecimalFractions = Option{func(c *Collator) { c.fractions = true }}
+
+// SignedNumbers makes a Collator treat a '-', '+' or '−' (U+2212 MINUS SIGN)
+// directly before a number as its sign, so negative numbers sort before
+// zero and positive numbers, in order of their value.
+// For example, "temp -10" < "temp -2" < "temp 0" < "temp +1" < "temp 3".
+//
+// To tell signs apart from hyphens, a sign must be at the start of the
+// string or follow a character that is neither a letter nor a digit.
+// So "temp-10" and "1-2" contain hyphens, while "temp:-10" and "(-2)" contain
+// negative numbers.
+// Numbers with the same value but a different sign, like "0" and "-0", are
+// ordered by how they are written.
+var SignedNumbers = Option{func(c *Collator) { c.signed = true }}
+
+// IgnoreCase makes a Collator compare letters case-insensitively, using
+// simple Unicode case folding like the casefolded package.
+// For example, "abc2" < "ABC12" < "abc100", and "abc" and "ABC" are equal.
+var IgnoreCase = Option{func(c *Collator) { c.ignoreCase = true }}
+
+// A ZeroPolicy determines the order of numbers that only differ in their
+// leading zeros.
+type ZeroPolicy int
+
+const (
+	// FewerZerosFirst orders numbers with fewer leading zeros first,
+	// like sortorder.NaturalLess: "2" < "02" < "002". This is the default.
+	FewerZerosFirst ZeroPolicy = iota
+	// MoreZerosFirst orders numbers with more leading zeros first:
+	// "002" < "02" < "2".
+	MoreZerosFirst
+	// ZerosIgnored ignores leading zeros completely, so "a01" and "a1" are
+	// equal. This is useful to find duplicates.
+	ZerosIgnored
+	// ZerosAsFraction compares numbers with leading zeros as if they were
+	// preceded by a decimal point, like Martin Pool's strnatcmp:
+	// "01" < "012" < "02" < "1" < "2". Their digits are compared left-aligned,
+	// and if one is a prefix of the other, the shorter one is less.
+	// Numbers with a decimal part (see DecimalFractions) are compared by
+	// value as usual, with fewer leading zeros first.
+	ZerosAsFraction
+)
+
+// LeadingZeros makes a Collator use the given policy for numbers with
+// leading zeros.
+func LeadingZeros(policy ZeroPolicy) Option {
+	return Option{func(c *Collator) { c.zeros = policy }}
+}
+
+// A Normalization determines which Unicode equivalent strings a Collator
+// compares as equal.
+type Normalization int
+
+const (
+	// NoNormalization compares strings as written. This is the default.
+	NoNormalization Normalization = iota
+	// Canonical compares canonically equivalent strings as equal, so e.g.
+	// "café" with a precomposed 'é' (NFC) equals "café" with 'e' followed by
+	// U+0301 COMBINING ACUTE ACCENT (NFD).
+	Canonical
+	// Compatibility also compares compatibility equivalent strings as equal,
+	// like NFKC and NFKD, so e.g. "ﬁ" == "fi" and "Ⅸ" == "IX".
+	Compatibility
+)
+
+// Normalize makes a Collator compare strings that are equivalent under the
+// given normalization as equal.
+//
+// Strings are compared by their decomposition (NFD or NFKD), so an accented
+// letter compares like its base letter followed by a combining accent:
+// "cafe" < "café" < "cafg".
+// Strings are decomposed incrementally while they are compared, without
+// normalizing them up front.
+// Digits that only appear after decomposition, like the '2' in '²', are
+// compared as characters, not as part of numbers.
+func Normalize(n Normalization) Option {
+	return Option{func(c *Collator) { c.normalization = n }}
+}
+
+// IgnoreAccents makes a Collator ignore accents and other diacritics
+// (nonspacing marks, Unicode category Mn, after canonical decomposition),
+// unless the strings are equal otherwise. For example, "résumé2" <
+// "resume10", and "Élan 3" < "elan 20" with IgnoreCase.
+//
+// Strings that only differ in their diacritics are ordered as if
+// Normalize(Canonical) was used without IgnoreAccents, so only canonically
+// equivalent strings are equal: "resume" < "résumé".
+var IgnoreAccents = Option{func(c *Collator) { c.ignoreAccents = true }}
+
+// A Level is a comparison level, as in the Unicode Collation Algorithm.
+// Strings are compared at each level in turn, and each level only decides
+// if the previous ones found the strings equal.
+type Level int
+
+const (
+	// Primary compares base letters and numbers, ignoring accents and case.
+	Primary Level = iota + 1
+	// Secondary also compares accents, still ignoring case.
+	Secondary
+	// Tertiary also compares case.
+	Tertiary
+	// Quaternary also compares the white space, punctuation and symbols
+	// that IgnorePunctuation ignores at the other levels.
+	Quaternary
+	// Identical also compares the strings bytewise, so only identical
+	// strings are equal.
+	Identical
+)
+
+// Strength makes a Collator compare strings up to the given level.
+// For example, with Strength(Secondary) "resume2" < "résumé2" < "Resume10",
+// and "resume" and "RESUME" are equal.
+//
+// Numbers are compared by value and leading zeros at the primary level.
+// Only Identical compares the way numbers are written, like the '２' in
+// "file２" with UnicodeDigits.
+// IgnoreCase still ignores case at all levels. Strings are decomposed like
+// with Normalize(Canonical), unless Normalize is used with another
+// normalization.
+func Strength(level Level) Option {
+	return Option{func(c *Collator) { c.strength = level }}
+}
+
+// A CaseOrder determines the order of letters that only differ in case.
+type CaseOrder int
+
+const (
+	// CodePointCase orders letters by code point, which puts upper-case
+	// ASCII letters before lower-case ones: "ABC" < "Abc" < "abc".
+	// Without IgnoreCase, this also applies to different letters, so
+	// "Zeta" < "alpha". This is the default.
+	CodePointCase CaseOrder = iota
+	// UpperFirst orders upper-case and title-case letters first:
+	// "ABC" < "Abc" < "abc" < "ABD".
+	UpperFirst
+	// LowerFirst orders lower-case letters first:
+	// "abc" < "Abc" < "ABC" < "abd".
+	LowerFirst
+)
+
+// CaseFirst makes a Collator ignore case, except to break ties between
+// strings that are equal otherwise. Those are ordered by the given case
+// order, comparing characters that differ in case by their case first, and
+// by code point next. For example, with CaseFirst(LowerFirst) "alpha" <
+// "Zeta" and "zeta2" < "Zeta2" < "zeta10".
+//
+// Like Strength(Tertiary), this compares case at the tertiary level.
+// It has no effect with IgnoreCase or Strength(Primary) or
+// Strength(Secondary).
+func CaseFirst(order CaseOrder) Option {
+	return Option{func(c *Collator) { c.caseOrder = order }}
+}
+
+// A CharClass is a class of characters, for use with ClassOrder.
+type CharClass int
+
+const (
+	// SpaceClass holds white space (Unicode property White_Space).
+	SpaceClass CharClass = iota
+	// PunctClass holds punctuation (Unicode category P).
+	PunctClass
+	// SymbolClass holds symbols (Unicode category S).
+	SymbolClass
+	// DigitClass holds numbers and other digits (Unicode category N).
+	DigitClass
+	// LetterClass holds letters and marks (Unicode categories L and M).
+	LetterClass
+	// OtherClass holds all other characters, like control characters and
+	// invalid UTF-8.
+	OtherClass
+
+	numClasses
+)
+
+// ClassOrder makes a Collator order characters by their class first, in the
+// given order, and only then by code point. For example, with
+// ClassOrder(SpaceClass, PunctClass, DigitClass, LetterClass),
+// "a b" < "a-b" < "a1" < "a2" < "a10" < "ab".
+// Numbers belong to DigitClass, and still sort before other digits.
+//
+// Classes that are not given sort after those that are, in the order of
+// their constants. Without this option, numbers sort before all other
+// characters, which are ordered by code point.
+func ClassOrder(order ...CharClass) Option {
+	return Option{func(c *Collator) {
+		rank := uint8(1)
+		c.classRanks = [numClasses]uint8{}
+		for _, class := range order {
+			if class >= 0 && class < numClasses && c.classRanks[class] == 0 {
+				c.classRanks[class] = rank
+				rank++
+			}
+		}
+		for class := range c.classRanks {
+			if c.classRanks[class] == 0 {
+				c.classRanks[class] = rank
+				rank++
+			}
+		}
+	}}
+}
+
+// ScriptOrder makes a Collator order letters by their script first, with
+// the given scripts (like unicode.Cyrillic) first, in the given order, and
+// all other scripts after them. For example, with
+// ScriptOrder(unicode.Cyrillic, unicode.Latin),
+// "Борис 2" < "Борис 10" < "Anna" < "Ωμέγα".
+//
+// Characters that are not part of a specific script, like white space,
+// punctuation and digits (those of unicode.Common), sort before all
+// scripts, unless their table is given too, while combining marks (those
+// of unicode.Inherited) sort like scripts that are not given. Numbers still
+// sort before all other characters and are compared naturally, and
+// characters are compared by code point within a script.
+// Nil and repeated tables are skipped, and only the first 250 scripts are
+// used.
+func ScriptOrder(scripts ...*unicode.RangeTable) Option {
+	return Option{func(c *Collator) {
+		c.scripts = nil
+		if order := rank.Scripts(scripts); len(order) > 0 {
+			c.scripts = &scriptOrder{slices.Clone(order)}
+		}
+	}}
+}
+
+// A scriptOrder holds the scripts in the order given to ScriptOrder.
+type scriptOrder struct {
+	tables []*unicode.RangeTable
+}
+
+// rank returns 0 for characters without a specific script, the position
+// plus one of the script of r in o, or len(o.tables)+1 if it isn't there.
+func (o *scriptOrder) rank(r rune) int {
+	return rank.Script(o.tables, r)
+}
+
+// spaceMode determines how a Collator compares white space.
+type spaceMode uint8
+
+const (
+	keepSpace spaceMode = iota
+	collapseSpace
+	ignoreSpace
+)
+
+// CollapseSpace makes a Collator compare every run of white space
+// (Unicode property White_Space, like tabs and U+00A0 NO-BREAK SPACE) as a
+// single ' ', and ignore white space at the start and end of strings.
+// For example, " Part  2" and "Part 2 " are equal, and both sort before
+// "Part 10". Numbers separated by white space remain separate numbers.
+var CollapseSpace = Option{func(c *Collator) { c.space = collapseSpace }}
+
+// IgnoreSpace makes a Collator ignore white space (Unicode property
+// White_Space) completely, so e.g. "Part 2", "Part2" and "Part\t2" are equal.
+// Numbers separated by white space remain separate numbers, so
+// "1 2" < "12".
+var IgnoreSpace = Option{func(c *Collator) { c.space = ignoreSpace }}
+
+// IgnorePunctuation makes a Collator ignore white space, punctuation and
+// symbols (see SpaceClass, PunctClass and SymbolClass), unless the strings
+// are equal otherwise. This is known as "alternate shifted" in the Unicode
+// Collation Algorithm. For example, "AB_3" < "AB 7" < "AB-12" < "AB.100",
+// and "AB12" < "AB-12".
+//
+// Digits on either side of an ignored character remain separate numbers,
+// so "1-2" < "12". Signs and decimal points that are part of a number (see
+// SignedNumbers and DecimalFractions) are not ignored.
+// The ignored characters are compared at the quaternary level, so they are
+// ignored completely with Strength(Tertiary) or lower.
+var IgnorePunctuation = Option{func(c *Collator) { c.ignorePunct = true }}
+
+// Equivalent makes a Collator compare the given characters as equal,
+// like the lowest of them. For example, with Equivalent('-', '_', ' ')
+// "build-3" < "build_12" < "build 100", and "a-b" and "a_b" are equal.
+// Characters can be made equivalent to several others by using this option
+// more than once.
+//
+// Equivalences don't apply to digits. They are applied before case folding,
+// so with IgnoreCase, Equivalent('-', 'x') doesn't make '-' equivalent to
+// 'X'. Use Equivalent('-', 'x', 'X') for that. If strings are decomposed
+// (see Normalize), equivalences apply to the decomposed characters.
+func Equivalent(runes ...rune) Option {
+	return Option{func(c *Collator) {
+		if len(runes) == 0 {
+			return
+		}
+		m := c.runeMap()
+		// Merge the classes of all the given characters.
+		lowest := slices.Min(runes)
+		merged := make(map[rune]bool)
+		for _, r := range runes {
+			if mapped, ok := m[r]; ok && mapped != ignoredRune {
+				merged[mapped] = true
+				lowest = min(lowest, mapped)
+			}
+			merged[r] = true
+		}
+		for r, mapped := range m {
+			if merged[mapped] {
+				m[r] = lowest
+			}
+		}
+		for _, r := range runes {
+			m[r] = lowest
+		}
+	}}
+}
+
+// IgnoreRunes makes a Collator ignore the given characters completely.
+// For example, with IgnoreRunes('-'), "a-b" and "ab" are equal.
+// Digits can't be ignored, and characters on either side of an ignored one
+// don't join, so "1-2" < "12".
+func IgnoreRunes(runes ...rune) Option {
+	return Option{func(c *Collator) {
+		m := c.runeMap()
+		for _, r := range runes {
+			m[r] = ignoredRune
+		}
+	}}
+}
+
+// NewCollator returns a Collator configured with the given options.
+func NewCollator(opts ...Option) *Collator {
+	c := new(Collator)
+	for _, opt := range opts {
+		if opt.apply != nil {
+			opt.apply(c)
+		}
+	}
+	return c
+}
+
+// Less reports whether str1 sorts before str2.
+func (c *Collator) Less(str1, str2 string) bool {
+	return c.Compare(str1, str2) < 0
+}
+
+// Compare compares two strings. The result will be 0 if str1 and str2 are
+// equivalent, -1 if str1 < str2, and +1 if str1 > str2.
+// A Collator without options only considers identical strings equivalent.
+// Options that ignore some differences between strings, or make characters
+// equal to each other, make other strings equivalent as well.
+func (c *Collator) Compare(str1, str2 string) int {
+	if c.isDefault() {
+		return sortorder.NaturalCompare(str1, str2)
+	}
+	first, last := c.levels()
+	res, tie := c.compare(c.scanner(str1, first), c.scanner(str2, first))
+	for level := first + 1; res == 0 && level <= last; level++ {
+		if c.sameLevels(level-1, level) {
+			continue
+		}
+		res, _ = c.compare(c.scanner(str1, level), c.scanner(str2, level))
+	}
+	switch {
+	case res != 0:
+		return res
+	case c.strength != 0 && c.strength < Identical:
+		return 0
+	case tie != 0:
+		// If the strings turn out to be equivalent, the first difference in
+		// the way their numbers are written decides.
+		return tie
+	case c.strength == Identical:
+		return cmp.Compare(str1, str2)
+	}
+	return 0
+}
+
+// levels returns the first and last level at which c compares tokens.
+// Without Strength, only IgnoreAccents, CaseFirst, IgnorePunctuation and
+// tailorings use more than one level.
+func (c *Collator) levels() (first, last Level) {
+	first, last = Tertiary, Tertiary
+	switch {
+	case c.strength != 0:
+		first, last = Primary, min(c.strength, Tertiary)
+	case c.ignoreAccents || c.tailoring != nil:
+		first = Primary
+	case c.caseOrder != CodePointCase:
+		first = Secondary
+	}
+	if c.ignorePunct && (c.strength == 0 || c.strength >= Quaternary) {
+		last = Quaternary
+	}
+	return first, last
+}
+
+// sameLevels reports whether c compares tokens the same way at both levels.
+func (c *Collator) sameLevels(l1, l2 Level) bool {
+	return c.ignoresAccents(l1) == c.ignoresAccents(l2) &&
+		c.ignoresCase(l1) == c.ignoresCase(l2) &&
+		c.ordersCase(l1) == c.ordersCase(l2) &&
+		c.ignoresPunct(l1) == c.ignoresPunct(l2) &&
+		(c.tailoring == nil || c.weightLevel(l1) == c.weightLevel(l2))
+}
+
+// ignoresPunct reports whether c ignores white space, punctuation and
+// symbols at the given level.
+func (c *Collator) ignoresPunct(level Level) bool {
+	return c.ignorePunct && level < Quaternary
+}
+
+// ignoresAccents reports whether c ignores accents at the given level.
+func (c *Collator) ignoresAccents(level Level) bool {
+	return level < Secondary && (c.strength != 0 || c.ignoreAccents)
+}
+
+// ignoresCase reports whether c ignores case at the given level.
+func (c *Collator) ignoresCase(level Level) bool {
+	return c.ignoreCase || level < Tertiary && (c.strength != 0 || c.caseOrder != CodePointCase)
+}
+
+// weightLevel returns the last level of tailored weights that c compares at
+// the given level.
+func (c *Collator) weightLevel(level Level) Level {
+	last := min(level, Tertiary)
+	if c.ignoresCase(level) {
+		last = min(last, Secondary)
+	}
+	return last
+}
+
+// ordersCase reports whether c orders characters by their case first at the
+// given level.
+func (c *Collator) ordersCase(level Level) bool {
+	return level == Tertiary && c.caseOrder != CodePointCase && !c.ignoreCase
+}
+
+// caseRank returns 0 for characters that come first in c's case order,
+// and 1 for all others.
+func (c *Collator) caseRank(r rune) int {
+	return rank.Case(r, c.caseOrder == LowerFirst)
+}
+
+// compare compares the tokens of two strings. If they are equivalent, it
+// also returns the comparison of the first numbers that are written
+// differently, if any.
+func (c *Collator) compare(s1, s2 scanner) (res, tie int) {
+	for {
+		t1, ok1 := s1.next()
+		t2, ok2 := s2.next()
+		switch {
+		case !ok1 || !ok2:
+			// At least one is ended. If the other continues, it sorts last.
+			if ok1 {
+				return +1, 0
+			}
+			if ok2 {
+				return -1, 0
+			}
+			return 0, tie
+		case c.ordersClasses() && c.classRank(&t1) != c.classRank(&t2):
+			return cmp.Compare(c.classRank(&t1), c.classRank(&t2)), 0
+		case t1.number != t2.number: // Digits before other characters.
+			if t1.number {
+				return -1, 0
+			}
+			return +1, 0
+		case !t1.number:
+			if t1.r != t2.r {
+				if c.scripts != nil {
+					if rank1, rank2 := c.scripts.rank(t1.r), c.scripts.rank(t2.r); rank1 != rank2 {
+						return cmp.Compare(rank1, rank2), 0
+					}
+				}
+				if s1.orderCase {
+					if rank1, rank2 := c.caseRank(t1.r), c.caseRank(t2.r); rank1 != rank2 {
+						return cmp.Compare(rank1, rank2), 0
+					}
+				}
+				return cmp.Compare(t1.r, t2.r), 0
+			}
+			if res := s1.compareWeights(t1.w, t2.w); res != 0 {
+				return res, 0
+			}
+		default:
+			if res := c.compareNumbers(&t1, &t2); res != 0 {
+				return res, 0
+			}
+			if tie == 0 {
+				tie = c.compareWritten(&t1, &t2)
+			}
+		}
+	}
+}
+
+// ordersClasses reports whether c orders characters by class first.
+func (c *Collator) ordersClasses() bool {
+	return c.classRanks != [numClasses]uint8{}
+}
+
+// classRank returns the rank of the class of a token.
+func (c *Collator) classRank(tok *token) int {
+	if tok.number {
+		return int(c.classRanks[DigitClass])
+	}
+	return int(c.classRanks[charClass(tok.r)])
+}
+
+// isDefault reports whether c has no options, so sortorder.NaturalCompare
+// can be used.
+func (c *Collator) isDefault() bool {
+	return *c == Collator{}
+}
+
+// Sorter returns a sort.Interface that sorts s using c, for use with
+// sort.Sort and sort.Stable.
+func (c *Collator) Sorter(s []string) sort.Interface {
+	return sorter{c, s}
+}
+
+type sorter struct {
+	c *Collator
+	s []string
+}
+
+func (s sorter) Len() int           { return len(s.s) }
+func (s sorter) Swap(i, j int)      { s.s[i], s.s[j] = s.s[j], s.s[i] }
+func (s sorter) Less(i, j int) bool { return s.c.Less(s.s[i], s.s[j]) }
@@ -0,0 +1,850 @@
+package collate
+
+import (
+	"bytes"
+	"cmp"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+	"unicode"
+
+	"github.com/fvbommel/sortorder"
+	"github.com/fvbommel/sortorder/casefolded"
+	"github.com/fvbommel/sortorder/internal/testgen"
+)
+
+func TestCollatorDefault(t *testing.T) {
+	var zero Collator
+	for _, c := range []*Collator{NewCollator(), &zero, NewCollator(Option{})} {
+		checkCollatorMatchesNatural(t, c)
+	}
+}
+
+func TestCollatorUnicodeDigits(t *testing.T) {
+	c := NewCollator(UnicodeDigits)
+	testset := []struct {
+		s1, s2 string
+		less   bool
+	}{
+		// Fullwidth digits.
+		{"ファイル２", "ファイル１０", true},
+		{"ファイル１０", "ファイル２", false},
+		// Arabic-Indic digits.
+		{"ملف٢", "ملف١٠", true},
+		{"ملف١٠", "ملف٢", false},
+		// Devanagari and Thai digits.
+		{"फ़ाइल२", "फ़ाइल१०", true},
+		{"ไฟล์๒", "ไฟล์๑๐", true},
+		// Mixed scripts compare by value.
+		{"file2", "file１０", true},
+		{"file٢", "file10", true},
+		{"file١٠", "file2", false},
+		// Leading zeros in other scripts.
+		{"a٠١", "a2", true},
+		{"a1", "a٠١", true},
+		{"a٠١", "a1", false},
+		// Equal values in different scripts are tie-broken by code point.
+		{"file2", "file２", true},
+		{"file２", "file2", false},
+		{"file２b", "file2c", true},
+		{"file2c", "file２b", false},
+		{"file２a2", "file2a10", true},
+		// Identical strings are equal.
+		{"file２", "file２", false},
+		// Not digits (category No).
+		{"a²", "a1", false},
+		{"a1", "a²", true},
+	}
+	for _, v := range testset {
+		if got := c.Less(v.s1, v.s2); got != v.less {
+			t.Errorf("Compared %#q to %#q: expected %v, got %v",
+				v.s1, v.s2, v.less, got)
+		}
+		checkCollatorConsistent(t, c, v.s1, v.s2)
+	}
+	checkCollatorMatchesNatural(t, c)
+}
+
+func TestDigitValue(t *testing.T) {
+	testset := []struct {
+		r     rune
+		value int
+	}{
+		{'0', 0}, {'9', 9}, {'a', -1}, {'²', -1},
+		{'٠', 0}, {'٩', 9}, {'۵', 5}, {'५', 5}, {'๗', 7}, {'１', 1}, {'９', 9},
+		{'𝟎', 0}, {'𝟗', 9}, {'𝟘', 0}, {'𝟡', 9}, {'𝟿', 9},
+	}
+	for _, v := range testset {
+		if got := digitValue(v.r); got != v.value {
+			t.Errorf("digitValue(%q) = %v, expected %v", v.r, got, v.value)
+		}
+	}
+}
+
+// checkCollatorMatchesNatural checks that c sorts ASCII strings exactly like
+// sortorder.NaturalCompare, including some invalid UTF-8.
+func checkCollatorMatchesNatural(t *testing.T, c *Collator) {
+	t.Helper()
+	gen := &generator{src: rand.New(rand.NewSource(300))}
+	for i := 0; i < 10000; i++ {
+		s1, s2 := gen.NextString(), gen.NextString()
+		if i%10 == 0 {
+			s1 += "\xff"
+		}
+		if got, want := c.Compare(s1, s2), sortorder.NaturalCompare(s1, s2); got != want {
+			t.Errorf("Compared %#q to %#q: expected %v, got %v", s1, s2, want, got)
+		}
+	}
+}
+
+// checkCollatorConsistent checks that comparing s1 to s2 is consistent with
+// comparing s2 to s1, and with Less.
+func checkCollatorConsistent(t *testing.T, c *Collator, s1, s2 string) {
+	t.Helper()
+	res := c.Compare(s1, s2)
+	if rev := c.Compare(s2, s1); res != -rev {
+		t.Errorf("Compared %#q to %#q: got %v, but reverse comparison gives %v", s1, s2, res, rev)
+	}
+	if less := c.Less(s1, s2); less != (res < 0) {
+		t.Errorf("Compared %#q to %#q: got %v, but Less returns %v", s1, s2, res, less)
+	}
+	if res == 0 && s1 != s2 {
+		t.Errorf("Compared %#q to %#q: expected only identical strings to be equal", s1, s2)
+	}
+}
+
+func TestCollatorDecimalFractions(t *testing.T) {
+	c := NewCollator(DecimalFractions)
+	testset := []struct {
+		s1, s2 string
+		less   bool
+	}{
+		{"sample_0.25mm", "sample_0.3mm", true},
+		{"sample_0.3mm", "sample_0.25mm", false},
+		{"sample_0.3mm", "sample_1mm", true},
+		{"sample_1mm", "sample_1.5mm", true},
+		{"1.10", "1.5", true},
+		{"1.5", "1.10", false},
+		{"1.05", "1.1", true},
+		{"1.9", "2", true},
+		{"10.01", "9.99", false},
+		{"x1.5", "x1a", false},
+		// Trailing zeros after the point are a tie-breaker.
+		{"0.5", "0.50", true},
+		{"0.50", "0.5", false},
+		{"0.50", "0.51", true},
+		{"00.5", "0.50", false},
+		// Version numbers are compared like integers.
+		{"1.5.0", "1.10.0", true},
+		{"1.10.0", "1.5.0", false},
+		{"v1.2.3", "v1.2.10", true},
+		{"10.0.0.1", "10.0.0.9", true},
+		{"1.2.3.4", "1.2.3.10", true},
+		// Version numbers follow decimal numbers with the same integer part.
+		{"1.2", "1.2.3", true},
+		{"1.2.3", "1.2", false},
+		{"1.25", "1.3.0", true},
+		{"1.9", "1.2.3", true},
+		{"1.2.3", "2", true},
+		{"0.5", "0.1.2", true},
+		{"0.1.2", "0.5", false},
+		// A dot without digits after it is not a decimal point.
+		{"1.", "1.0", true},
+		{"1.x", "1.5", true},
+		{".5", ".25", true},
+	}
+	for _, v := range testset {
+		if got := c.Less(v.s1, v.s2); got != v.less {
+			t.Errorf("Compared %#q to %#q: expected %v, got %v",
+				v.s1, v.s2, v.less, got)
+		}
+		checkCollatorConsistent(t, c, v.s1, v.s2)
+	}
+
+	checkStrictWeakOrder(t, NewCollator(SignedNumbers, DecimalFractions),
+		[]string{"0", "0.1.2", "0.5", "1", "1.", "1.0", "1.2", "1.2.3", "1.25", "1.3.0", "1.9", "1.10.2", "01.2.3", "2", "-1.2", "-1.2.3", "-1.9"})
+
+	// Fractions can use any decimal digits with UnicodeDigits.
+	c = NewCollator(UnicodeDigits, DecimalFractions)
+	for _, v := range []struct{ s1, s2 string }{
+		{"０.２５", "０.３"},
+		{"0.5", "０.５"},
+		{"０.５", "0.50"},
+	} {
+		if !c.Less(v.s1, v.s2) {
+			t.Errorf("Compared %#q to %#q: expected true, got false", v.s1, v.s2)
+		}
+		checkCollatorConsistent(t, c, v.s1, v.s2)
+	}
+}
+
+func TestCollatorSignedNumbers(t *testing.T) {
+	c := NewCollator(SignedNumbers)
+	testset := []struct {
+		s1, s2 string
+		less   bool
+	}{
+		{"temp -10", "temp -2", true},
+		{"temp -2", "temp -10", false},
+		{"temp -2", "temp 0", true},
+		{"temp 0", "temp +1", true},
+		{"temp +1", "temp 3", true},
+		{"temp -1", "temp 3", true},
+		{"temp −5", "temp -1", true},
+		{"-5", "-1", true},
+		{"-1", "0", true},
+		{"+2", "10", true},
+		{"(-2)", "(1)", true},
+		{"x=-3", "x=-20", false},
+		// Zeros and trailing zeros still break ties.
+		{"-5", "-05", true},
+		{"-05", "-5", false},
+		// Zero is zero, regardless of its sign.
+		{"-0", "-1", false},
+		{"-0", "1", true},
+		{"+0", "-0", true},
+		{"-0", "0", true},
+		{"-0a", "0b", true},
+		// Hyphens after letters or digits are not signs.
+		{"temp-2", "temp-10", true},
+		{"1-2", "1-10", true},
+		{"a-", "a-1", true},
+		// A sign without digits is not a sign.
+		{"-5", "- 5", true},
+	}
+	for _, v := range testset {
+		if got := c.Less(v.s1, v.s2); got != v.less {
+			t.Errorf("Compared %#q to %#q: expected %v, got %v",
+				v.s1, v.s2, v.less, got)
+		}
+		checkCollatorConsistent(t, c, v.s1, v.s2)
+	}
+
+	// Signs combine with decimal fractions.
+	c = NewCollator(SignedNumbers, DecimalFractions)
+	want := []string{"-1.5", "-1.25", "-0.5", "0.0", "0.25", "1.5"}
+	for i := range want[1:] {
+		if !c.Less(want[i], want[i+1]) {
+			t.Errorf("Compared %#q to %#q: expected true, got false", want[i], want[i+1])
+		}
+		checkCollatorConsistent(t, c, want[i], want[i+1])
+	}
+}
+
+func TestCollatorIgnoreCase(t *testing.T) {
+	c := NewCollator(IgnoreCase)
+	testset := []struct {
+		s1, s2 string
+		cmp    int
+	}{
+		{"abc2", "ABC12", -1},
+		{"ABC12", "abc100", -1},
+		{"abc", "ABC", 0},
+		{"Zeta", "alpha", +1},
+		{"k", "K", 0},
+		{"straße", "STRAẞE", 0},
+		{"АБВ2", "абв10", -1},
+		{"ab1c", "ab01C", -1},
+	}
+	for _, v := range testset {
+		if got := c.Compare(v.s1, v.s2); got != v.cmp {
+			t.Errorf("Compared %#q to %#q: expected %v, got %v",
+				v.s1, v.s2, v.cmp, got)
+		}
+	}
+
+	// IgnoreCase compares like casefolded.NaturalCompare.
+	gen := testgen.New("0", "0", "1", "2", "9", "a", "A", "k", "K", "K", "s", "S", "ſ", "б", "Б", "ǅ", "ǆ")
+	for i := 0; i < 10000; i++ {
+		s1, s2 := gen.Next(), gen.Next()
+		if got, want := c.Compare(s1, s2), casefolded.NaturalCompare(s1, s2); got != want {
+			t.Errorf("Compared %#q to %#q: expected %v, got %v", s1, s2, want, got)
+		}
+	}
+}
+
+func TestCollatorLeadingZeros(t *testing.T) {
+	testset := []struct {
+		policy ZeroPolicy
+		want   []string
+	}{
+		{FewerZerosFirst, []string{"a1", "a01", "a001", "a2", "a02", "a10"}},
+		{MoreZerosFirst, []string{"a001", "a01", "a1", "a02", "a2", "a10"}},
+		{ZerosIgnored, []string{"a001", "a1", "a01", "a2", "a02", "a10"}}, // Stable.
+		{ZerosAsFraction, []string{"a001", "a01", "a010", "a02", "a1", "a2", "a10"}},
+	}
+	for _, v := range testset {
+		c := NewCollator(LeadingZeros(v.policy))
+		got := []string{"a2", "a10", "a001", "a02", "a1", "a01"}
+		if v.policy == ZerosAsFraction {
+			got = append(got, "a010")
+		}
+		sort.Stable(c.Sorter(got))
+		if !reflect.DeepEqual(v.want, got) {
+			t.Errorf("Error: sort with policy %v failed, expected: %#q, got: %#q", v.policy, v.want, got)
+		}
+	}
+
+	testset2 := []struct {
+		opts   []Option
+		s1, s2 string
+		cmp    int
+	}{
+		{[]Option{LeadingZeros(ZerosIgnored)}, "a01", "a1", 0},
+		{[]Option{LeadingZeros(ZerosIgnored)}, "a0", "a000", 0},
+		{[]Option{LeadingZeros(ZerosIgnored), UnicodeDigits}, "a٠1", "a1", 0},
+		{[]Option{LeadingZeros(ZerosIgnored), UnicodeDigits}, "a0٢", "a2", +1},
+		{[]Option{LeadingZeros(ZerosIgnored), SignedNumbers}, "-01", "-1", 0},
+		{[]Option{LeadingZeros(ZerosIgnored), Strength(Identical)}, "a01", "a1", -1},
+		{[]Option{LeadingZeros(ZerosAsFraction)}, "1.002", "1.010", -1},
+		{[]Option{LeadingZeros(ZerosAsFraction)}, "1.010", "1.02", -1},
+		{[]Option{LeadingZeros(ZerosAsFraction)}, "1.02", "1.1", -1},
+		{[]Option{LeadingZeros(ZerosAsFraction)}, "x0", "x00", -1},
+		{[]Option{LeadingZeros(ZerosAsFraction)}, "x00", "x05", -1},
+		{[]Option{LeadingZeros(ZerosAsFraction)}, "x05", "x050", -1},
+		{[]Option{LeadingZeros(ZerosAsFraction)}, "x099", "x1", -1},
+		{[]Option{LeadingZeros(ZerosAsFraction), SignedNumbers}, "-05", "-01", -1},
+		{[]Option{LeadingZeros(ZerosAsFraction), SignedNumbers}, "-05", "0", -1},
+		{[]Option{LeadingZeros(ZerosAsFraction), DecimalFractions}, "05.5", "1", +1},
+		{[]Option{LeadingZeros(ZerosAsFraction), DecimalFractions}, "5.5", "05.5", -1},
+	}
+	for _, v := range testset2 {
+		c := NewCollator(v.opts...)
+		if got := c.Compare(v.s1, v.s2); got != v.cmp {
+			t.Errorf("Compared %#q to %#q: expected %v, got %v", v.s1, v.s2, v.cmp, got)
+		}
+	}
+}
+
+func TestCollatorLeadingZerosStrictWeakOrder(t *testing.T) {
+	gen := testgen.New("0", "0", "0", "1", "2", "٠", "٢", "-", ".", "a")
+	strs := make([]string, 40)
+	for i := range strs {
+		strs[i] = gen.NextN(1, 5)
+	}
+	for _, policy := range []ZeroPolicy{FewerZerosFirst, MoreZerosFirst, ZerosIgnored, ZerosAsFraction} {
+		for _, c := range []*Collator{
+			NewCollator(LeadingZeros(policy)),
+			NewCollator(LeadingZeros(policy), UnicodeDigits, SignedNumbers, DecimalFractions),
+		} {
+			checkStrictWeakOrder(t, c, strs)
+		}
+	}
+}
+
+// checkStrictWeakOrder checks that c.Compare is a strict weak ordering of
+// strs: comparisons are antisymmetric, and both less-than and equality are
+// transitive.
+func checkStrictWeakOrder(t *testing.T, c *Collator, strs []string) {
+	t.Helper()
+	for _, a := range strs {
+		for _, b := range strs {
+			ab := c.Compare(a, b)
+			if ba := c.Compare(b, a); ab != -ba {
+				t.Errorf("Compared %#q to %#q: got %v, but reverse comparison gives %v", a, b, ab, ba)
+			}
+			for _, d := range strs {
+				bd := c.Compare(b, d)
+				if ab == bd && ab != +1 && c.Compare(a, d) != ab {
+					t.Errorf("Compared %#q, %#q and %#q: got %v twice, but %v from first to last",
+						a, b, d, ab, c.Compare(a, d))
+				}
+			}
+		}
+	}
+}
+
+func TestCollatorNormalize(t *testing.T) {
+	testset := []struct {
+		n      Normalization
+		s1, s2 string
+		cmp    int
+	}{
+		{Canonical, "caf\u00e9 2", "cafe\u0301 10", -1},
+		{Canonical, "cafe\u0301 10", "caf\u00e9 2", +1},
+		{Canonical, "caf\u00e9", "cafe\u0301", 0},
+		{Canonical, "cafe", "caf\u00e9", -1},
+		{Canonical, "caf\u00e9", "cafg", -1},
+		{Canonical, "caf\u00e92", "cafe\u03012", 0},
+		// Combining marks are reordered canonically.
+		{Canonical, "a\u0323\u0302", "a\u0302\u0323", 0},
+		{Canonical, "\u1ead", "a\u0302\u0323", 0},
+		// Hangul syllables and jamo.
+		{Canonical, "\uac00", "\u1100\u1161", 0},
+		// Compatibility equivalents are not canonically equivalent.
+		{Canonical, "\ufb01", "fi", +1},
+		{Compatibility, "\ufb01", "fi", 0},
+		{Compatibility, "\ufb01le2", "file10", -1},
+		{Compatibility, "\u2168", "IX", 0},
+		{Compatibility, "caf\u00e9", "cafe\u0301", 0},
+		// Compatibility digits are not numbers.
+		{Compatibility, "a\u00b2", "a2", +1},
+		{Compatibility, "a\u00b2", "a\u00b2", 0},
+		// Invalid UTF-8 stays distinct.
+		{Canonical, "e\xff", "e\u0301", +1},
+		{Canonical, "\xffe\u0301", "\xff\u00e9", 0},
+	}
+	for _, v := range testset {
+		c := NewCollator(Normalize(v.n))
+		if got := c.Compare(v.s1, v.s2); got != v.cmp {
+			t.Errorf("Normalization %v: compared %+q to %+q: expected %v, got %v",
+				v.n, v.s1, v.s2, v.cmp, got)
+		}
+	}
+
+	// Normalization combines with case folding.
+	c := NewCollator(Normalize(Canonical), IgnoreCase)
+	if got := c.Compare("CAF\u00c9 2", "cafe\u0301 10"); got != -1 {
+		t.Errorf("Compared with IgnoreCase: expected -1, got %v", got)
+	}
+	checkCollatorMatchesNatural(t, NewCollator(Normalize(Compatibility)))
+}
+
+func TestCollatorIgnoreAccents(t *testing.T) {
+	c := NewCollator(IgnoreAccents)
+	testset := []struct {
+		s1, s2 string
+		cmp    int
+	}{
+		{"résumé2", "resume10", -1},
+		{"resume10", "résumé2", +1},
+		{"résumé", "resume", +1},
+		{"resume", "résumé", -1},
+		{"résumé", "resumes", -1},
+		{"naïve", "naive", +1},
+		{"naïve", "naivf", -1},
+		{"Ångström", "Angstrom", +1},
+		{"Ångström", "Angstron", -1},
+		// Canonically equivalent strings are equal.
+		{"caf\u00e9", "cafe\u0301", 0},
+		{"\u1ead", "a\u0302\u0323", 0},
+		// Marks between digits separate numbers.
+		{"1\u03012", "12", -1},
+		// Numbers, including their leading zeros, decide before diacritics.
+		{"é2", "e10", -1},
+		{"é1", "e01", -1},
+		// Case still matters.
+		{"Elan", "élan", -1},
+	}
+	for _, v := range testset {
+		if got := c.Compare(v.s1, v.s2); got != v.cmp {
+			t.Errorf("Compared %+q to %+q: expected %v, got %v",
+				v.s1, v.s2, v.cmp, got)
+		}
+	}
+
+	c = NewCollator(IgnoreAccents, IgnoreCase)
+	want := []string{"elan 2", "Élan 3", "ÉLAN 10", "elan 20", "Elba"}
+	got := []string{"elan 20", "Elba", "ÉLAN 10", "Élan 3", "elan 2"}
+	sort.Sort(c.Sorter(got))
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Error: sort failed, expected: %#q, got: %#q", want, got)
+	}
+	checkCollatorMatchesNatural(t, NewCollator(IgnoreAccents))
+}
+
+func TestCollatorStrength(t *testing.T) {
+	testset := []struct {
+		level Level
+		want  [][]string // Groups of equal strings, in order.
+	}{
+		{Primary, [][]string{
+			{"resume", "résumé", "RESUME", "Résumé", "re\u0301sume\u0301"},
+			{"resume2", "Résumé2"},
+			{"resume02"},
+			{"Resume10"},
+		}},
+		{Secondary, [][]string{
+			{"resume", "RESUME"},
+			{"résumé", "Résumé", "re\u0301sume\u0301"},
+			{"resume2"},
+			{"Résumé2"},
+			{"resume02"},
+			{"Resume10"},
+		}},
+		{Tertiary, [][]string{
+			{"RESUME"}, {"resume"},
+			{"Résumé"}, {"résumé", "re\u0301sume\u0301"},
+			{"resume2"}, {"Résumé2"}, {"resume02"}, {"Resume10"},
+		}},
+		{Identical, [][]string{
+			{"RESUME"}, {"resume"},
+			{"Résumé"}, {"re\u0301sume\u0301"}, {"résumé"},
+			{"resume2"}, {"Résumé2"}, {"resume02"}, {"Resume10"},
+		}},
+	}
+	for _, v := range testset {
+		c := NewCollator(Strength(v.level))
+		for i, group1 := range v.want {
+			for j, group2 := range v.want {
+				for _, s1 := range group1 {
+					for _, s2 := range group2 {
+						if got, want := c.Compare(s1, s2), cmp.Compare(i, j); got != want {
+							t.Errorf("Level %v: compared %+q to %+q: expected %v, got %v",
+								v.level, s1, s2, want, got)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	// Only Identical compares how numbers are written.
+	for _, level := range []Level{Primary, Secondary, Tertiary, Identical} {
+		c := NewCollator(Strength(level), UnicodeDigits)
+		want := 0
+		if level == Identical {
+			want = -1
+		}
+		if got := c.Compare("file2", "file\uff12"); got != want {
+			t.Errorf("Level %v: compared %+q to %+q: expected %v, got %v",
+				level, "file2", "file\uff12", want, got)
+		}
+	}
+	checkCollatorMatchesNatural(t, NewCollator(Strength(Identical)))
+}
+
+func TestCollatorCaseFirst(t *testing.T) {
+	testset := []struct {
+		opts []Option
+		want []string
+	}{
+		{
+			[]Option{CaseFirst(LowerFirst)},
+			[]string{"abc", "Abc", "ABC", "abd", "alpha", "zeta2", "Zeta2", "zeta10", "Zeta10"},
+		},
+		{
+			[]Option{CaseFirst(UpperFirst)},
+			[]string{"ABC", "Abc", "abc", "abd", "alpha", "Zeta2", "zeta2", "Zeta10", "zeta10"},
+		},
+		{
+			[]Option{CaseFirst(UpperFirst), IgnoreAccents},
+			[]string{"Elan", "elan", "Élan", "élan", "Elan2", "élan2", "elan10"},
+		},
+		{
+			[]Option{CaseFirst(LowerFirst), Strength(Identical)},
+			[]string{"\u01c6", "\u01c4", "\u01c5"},
+		},
+		{
+			[]Option{CaseFirst(LowerFirst)},
+			[]string{"straße", "straẞe", "STRAẞE", "straßf"},
+		},
+	}
+	for _, v := range testset {
+		c := NewCollator(v.opts...)
+		got := []string{}
+		for i := len(v.want) - 1; i >= 0; i-- {
+			got = append(got, v.want[i])
+		}
+		sort.Sort(c.Sorter(got))
+		if !reflect.DeepEqual(v.want, got) {
+			t.Errorf("Error: sort failed, expected: %#q, got: %#q", v.want, got)
+		}
+		for i := range v.want[1:] {
+			checkCollatorConsistent(t, c, v.want[i], v.want[i+1])
+		}
+	}
+
+	// CaseFirst has no effect when case is ignored.
+	c := NewCollator(CaseFirst(LowerFirst), IgnoreCase)
+	if got := c.Compare("abc", "ABC"); got != 0 {
+		t.Errorf("Compared with IgnoreCase: expected 0, got %v", got)
+	}
+	checkCollatorMatchesNatural(t, NewCollator(CaseFirst(CodePointCase)))
+}
+
+func TestCollatorClassOrder(t *testing.T) {
+	testset := []struct {
+		order []CharClass
+		want  []string
+	}{
+		{
+			[]CharClass{SpaceClass, PunctClass, DigitClass, LetterClass},
+			[]string{"a\tc", "a b", "a-b", "a_b", "a1", "a2", "a10", "a²", "ab", "a+b", "a\x00"},
+		},
+		{
+			[]CharClass{LetterClass, DigitClass},
+			[]string{"ab", "a1", "a2", "a10", "a b", "a-b", "a+b"},
+		},
+		{
+			// Unlisted classes keep the order of their constants.
+			[]CharClass{OtherClass, SymbolClass},
+			[]string{"a\xff", "a+", "a€", "a ", "a.", "a1", "ab", "aé"},
+		},
+	}
+	for _, v := range testset {
+		c := NewCollator(ClassOrder(v.order...))
+		for i := range v.want {
+			for j := range v.want {
+				if got, want := c.Compare(v.want[i], v.want[j]), cmp.Compare(i, j); got != want {
+					t.Errorf("Order %v: compared %#q to %#q: expected %v, got %v",
+						v.order, v.want[i], v.want[j], want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestCollatorScriptOrder(t *testing.T) {
+	testset := []struct {
+		opts []Option
+		want []string
+	}{
+		{
+			[]Option{ScriptOrder(unicode.Cyrillic, unicode.Latin)},
+			[]string{"Борис 2", "Борис 10", "Вера", "борис", "Anna", "Zoe", "anna", "Ωμέγα", "北京"},
+		},
+		{
+			[]Option{ScriptOrder(unicode.Greek), IgnoreCase},
+			[]string{"αβ", "ΑΒΓ", "ωμέγα", "Anna 2", "anna 10", "Борис", "北京"},
+		},
+		{
+			// White space and punctuation sort before all scripts, and
+			// numbers before that.
+			[]Option{ScriptOrder(unicode.Han, unicode.Cyrillic)},
+			[]string{"第", "第2章", "第10章", "第 2", "第-2", "第章", "第б", "第a"},
+		},
+		{
+			// Unless their table is given.
+			[]Option{ScriptOrder(unicode.Latin, unicode.Common)},
+			[]string{"a", "a2", "ab", "a-", "aα"},
+		},
+		{
+			[]Option{ScriptOrder(nil, unicode.Hebrew, unicode.Hebrew, unicode.Arabic), IgnoreAccents},
+			[]string{"שָׁלוֹם", "שלום", "سلام", "Shalom"},
+		},
+	}
+	for _, v := range testset {
+		c := NewCollator(v.opts...)
+		for i := range v.want {
+			for j := range v.want {
+				if got, want := c.Compare(v.want[i], v.want[j]), cmp.Compare(i, j); got != want {
+					t.Errorf("Compared %#q to %#q: expected %v, got %v", v.want[i], v.want[j], want, got)
+				}
+			}
+		}
+	}
+	if c := NewCollator(ScriptOrder(unicode.Latin), ScriptOrder()); !c.isDefault() {
+		t.Errorf("ScriptOrder() doesn't reset the script order")
+	}
+}
+
+func TestCollatorSpace(t *testing.T) {
+	testset := []struct {
+		opt    Option
+		s1, s2 string
+		cmp    int
+	}{
+		{CollapseSpace, "Part  2", "Part 2", 0},
+		{CollapseSpace, "Part\t2", "Part\u00a02", 0},
+		{CollapseSpace, " Part 2 ", "Part 2", 0},
+		{CollapseSpace, "Part  2", "Part 10", -1},
+		{CollapseSpace, "Part 10", "Part  2", +1},
+		{CollapseSpace, "Part 2", "Part2", +1},
+		{CollapseSpace, "a b", "a-b", -1},
+		{CollapseSpace, "1 2", "1  2", 0},
+		{CollapseSpace, "1 2", "12", -1},
+		{CollapseSpace, "   ", "", 0},
+		{IgnoreSpace, "Part 2", "Part2", 0},
+		{IgnoreSpace, "Part\u3000 2 ", " Part2", 0},
+		{IgnoreSpace, "Part 2", "Part 10", -1},
+		{IgnoreSpace, "P art", "Pa rt", 0},
+		{IgnoreSpace, "1 2", "12", -1},
+		{IgnoreSpace, "1 2", "1\t2", 0},
+		{IgnoreSpace, "a b", "a-b", +1},
+	}
+	for _, v := range testset {
+		c := NewCollator(v.opt)
+		if got := c.Compare(v.s1, v.s2); got != v.cmp {
+			t.Errorf("Compared %#q to %#q: expected %v, got %v", v.s1, v.s2, v.cmp, got)
+		}
+	}
+
+	// Only Identical distinguishes white space.
+	c := NewCollator(CollapseSpace, Strength(Identical))
+	if got := c.Compare("Part  2", "Part 2"); got != -1 {
+		t.Errorf("Compared with Strength(Identical): expected -1, got %v", got)
+	}
+	checkCollatorMatchesNatural(t, NewCollator(CollapseSpace))
+}
+
+func TestCollatorIgnorePunctuation(t *testing.T) {
+	c := NewCollator(IgnorePunctuation)
+	want := []string{"AB_3", "AB 7", "AB12", "AB-12", "AB.100", "AB€200", "A-BC", "ABC"}
+	got := []string{"ABC", "AB.100", "AB-12", "A-BC", "AB 7", "AB_3", "AB€200", "AB12"}
+	sort.Sort(c.Sorter(got))
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Error: sort failed, expected: %#q, got: %#q", want, got)
+	}
+	for i := range want[1:] {
+		checkCollatorConsistent(t, c, want[i], want[i+1])
+	}
+
+	testset := []struct {
+		opts   []Option
+		s1, s2 string
+		cmp    int
+	}{
+		// Separated digits remain separate numbers.
+		{nil, "1-2", "12", -1},
+		{nil, "1-2", "1_2", -1},
+		{nil, "1-2", "1-10", -1},
+		// Signs and decimal points are part of numbers.
+		{[]Option{SignedNumbers}, "x -5", "x 3", -1},
+		{[]Option{DecimalFractions}, "x1.5", "x1.25", +1},
+		// Punctuation is compared after case.
+		{[]Option{CaseFirst(LowerFirst)}, "a-b", "A_B", -1},
+		{[]Option{CaseFirst(LowerFirst)}, "ab", "a-b", +1},
+		// Only the quaternary level compares punctuation.
+		{[]Option{Strength(Tertiary)}, "AB-12", "AB_12", 0},
+		{[]Option{Strength(Quaternary)}, "AB-12", "AB_12", -1},
+	}
+	for _, v := range testset {
+		c := NewCollator(append(v.opts, IgnorePunctuation)...)
+		if got := c.Compare(v.s1, v.s2); got != v.cmp {
+			t.Errorf("Compared %#q to %#q: expected %v, got %v", v.s1, v.s2, v.cmp, got)
+		}
+	}
+	checkCollatorMatchesNatural(t, c)
+}
+
+func TestCollatorEquivalent(t *testing.T) {
+	c := NewCollator(Equivalent('-', '_', ' '))
+	want := []string{"build-3", "build_12", "build 100", "build+1"}
+	got := []string{"build 100", "build+1", "build_12", "build-3"}
+	sort.Sort(c.Sorter(got))
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Error: sort failed, expected: %#q, got: %#q", want, got)
+	}
+
+	testset := []struct {
+		opts   []Option
+		s1, s2 string
+		cmp    int
+	}{
+		{[]Option{Equivalent('-', '_', ' ')}, "a-b", "a_b", 0},
+		{[]Option{Equivalent('-', '_', ' ')}, "a b", "a-b", 0},
+		{[]Option{Equivalent('-', '_', ' ')}, "a-1", "a_01", -1},
+		{[]Option{Equivalent('-', '_', ' ')}, "a_01", "a-1", +1},
+		{[]Option{Equivalent('_', ' ')}, "a_b", "a-b", -1},
+		// Equivalences are merged.
+		{[]Option{Equivalent('a', 'b'), Equivalent('c', 'b')}, "ac", "ca", 0},
+		{[]Option{Equivalent('b', 'c'), Equivalent('a', 'd'), Equivalent('c', 'd')}, "ab", "dc", 0},
+		// Digits aren't affected.
+		{[]Option{Equivalent('1', '2')}, "a1", "a2", -1},
+		{[]Option{IgnoreRunes('1')}, "a1", "a", +1},
+		// Case folding applies after equivalence.
+		{[]Option{Equivalent('-', 'x'), IgnoreCase}, "-", "x", 0},
+		{[]Option{Equivalent('-', 'x'), IgnoreCase}, "-", "X", -1},
+		{[]Option{Equivalent('-', 'x', 'X'), IgnoreCase}, "-", "X", 0},
+		{[]Option{IgnoreRunes('-', '.')}, "a-b", "ab", 0},
+		{[]Option{IgnoreRunes('-', '.')}, "a.-.b", "ab", 0},
+		{[]Option{IgnoreRunes('-')}, "1-2", "12", -1},
+		{[]Option{IgnoreRunes('-')}, "a-2", "a10", -1},
+		{[]Option{IgnoreRunes('-')}, "a-01", "a1", +1},
+		// Decomposed characters are never precomposed.
+		{[]Option{IgnoreRunes('é'), Normalize(Canonical)}, "é", "e\u0301", 0},
+	}
+	for _, v := range testset {
+		c := NewCollator(v.opts...)
+		if got := c.Compare(v.s1, v.s2); got != v.cmp {
+			t.Errorf("Compared %#q to %#q: expected %v, got %v", v.s1, v.s2, v.cmp, got)
+		}
+	}
+}
+
+func TestCollatorSorter(t *testing.T) {
+	want := []string{"-10", "-2", "0.5", "1", "ABC1", "abc01", "abc2", "Abc10"}
+	got := []string{"abc2", "1", "0.5", "Abc10", "-2", "abc01", "ABC1", "-10"}
+	c := NewCollator(SignedNumbers, DecimalFractions, IgnoreCase)
+	sort.Stable(c.Sorter(got))
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Error: sort failed, expected: %#q, got: %#q", want, got)
+	}
+}
+
+func TestCollatorKey(t *testing.T) {
+	collators := map[string]*Collator{
+		"default":           NewCollator(),
+		"UnicodeDigits":     NewCollator(UnicodeDigits),
+		"DecimalFractions":  NewCollator(DecimalFractions),
+		"SignedNumbers":     NewCollator(SignedNumbers),
+		"IgnoreCase":        NewCollator(IgnoreCase),
+		"MoreZerosFirst":    NewCollator(LeadingZeros(MoreZerosFirst)),
+		"all":               NewCollator(UnicodeDigits, DecimalFractions, SignedNumbers, IgnoreCase, LeadingZeros(MoreZerosFirst)),
+		"signed fractions":  NewCollator(DecimalFractions, SignedNumbers),
+		"unicode fractions": NewCollator(UnicodeDigits, DecimalFractions),
+		"Canonical":         NewCollator(Normalize(Canonical)),
+		"Compatibility":     NewCollator(Normalize(Compatibility), IgnoreCase),
+		"IgnoreAccents":     NewCollator(IgnoreAccents, UnicodeDigits, SignedNumbers),
+		"Primary":           NewCollator(Strength(Primary)),
+		"Secondary":         NewCollator(Strength(Secondary), UnicodeDigits),
+		"Tertiary":          NewCollator(Strength(Tertiary), Normalize(Compatibility)),
+		"Identical":         NewCollator(Strength(Identical), SignedNumbers, IgnoreCase),
+		"LowerFirst":        NewCollator(CaseFirst(LowerFirst)),
+		"UpperFirst":        NewCollator(CaseFirst(UpperFirst), IgnoreAccents, UnicodeDigits),
+		"ZerosIgnored":      NewCollator(LeadingZeros(ZerosIgnored), UnicodeDigits, SignedNumbers),
+		"ZerosAsFraction":   NewCollator(LeadingZeros(ZerosAsFraction), SignedNumbers, DecimalFractions),
+		"ClassOrder":        NewCollator(ClassOrder(LetterClass, SpaceClass, PunctClass), IgnoreCase),
+		"CollapseSpace":     NewCollator(CollapseSpace, SignedNumbers),
+		"IgnoreSpace":       NewCollator(IgnoreSpace, DecimalFractions, Normalize(Compatibility)),
+		"IgnorePunctuation": NewCollator(IgnorePunctuation, SignedNumbers),
+		"Quaternary":        NewCollator(IgnorePunctuation, Strength(Quaternary), CaseFirst(UpperFirst)),
+		"Equivalent":        NewCollator(Equivalent('-', '.', 'a'), IgnoreRunes('K', '+'), IgnoreCase),
+		"Tailor":            NewCollator(MustTailor("&a << é <<< É < k &ka < é- &- = ."), Strength(Identical)),
+		"Alphabet":          NewCollator(MustAlphabet("k", "a", "ak"), IgnoreAccents, Normalize(Canonical), CaseFirst(LowerFirst)),
+		"ScriptOrder":       NewCollator(ScriptOrder(unicode.Greek, unicode.Latin), CaseFirst(UpperFirst), UnicodeDigits),
+		"Japanese":          NewCollator(Japanese, Strength(Identical)),
+	}
+	// Mix digits, signs, dots, letters and invalid UTF-8.
+	gen := testgen.New(
+		"0", "0", "1", "2", "9", "٠", "٢", "０", "５",
+		"-", "+", "−", ".", ".", " ", " ", "\t", "\u3000",
+		"a", "A", "k", "K", "é", "É", "\x00", "\xff", "α", "Ω",
+		"か", "ガ", "ｶ", "ﾞ", "ー", "ア",
+	)
+	for name, c := range collators {
+		for i := 0; i < 10000; i++ {
+			s1, s2 := gen.Next(), gen.Next()
+			k1, k2 := c.Key(s1), c.Key(s2)
+			if got, want := bytes.Compare(k1, k2), c.Compare(s1, s2); got != want {
+				t.Errorf("%s: compared keys of %+q and %+q: expected %v, got %v (keys %x and %x)",
+					name, s1, s2, want, got, k1, k2)
+			}
+			if got, want := c.Compare(s1, s2), -c.Compare(s2, s1); got != want {
+				t.Errorf("%s: compared %+q to %+q: got %v, but reverse comparison gives %v",
+					name, s1, s2, got, -want)
+			}
+		}
+	}
+}
+
+type generator struct {
+	src *rand.Rand
+}
+
+// Gets random random-length alphanumeric string.
+func (g *generator) NextString() (str string) {
+	// Random-length 3-8 chars part
+	strlen := g.src.Intn(6) + 3
+	// Random-length 1-3 num
+	numlen := g.src.Intn(3) + 1
+	// Random position for num in string
+	numpos := g.src.Intn(strlen + 1)
+	// Generate the number
+	var num string
+	for i := 0; i < numlen; i++ {
+		num += strconv.Itoa(g.src.Intn(10))
+	}
+	// Put it all together
+	for i := 0; i < strlen+1; i++ {
+		if i == numpos {
+			str += num
+		} else {
+			str += string('a' + rune(g.src.Intn(16)))
+		}
+	}
+	return str
+}
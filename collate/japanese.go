@@ -0,0 +1,168 @@
+package collate
+
+import (
+	"strings"
+	"sync"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Japanese sorts kana in gojūon order, the order of the Japanese syllabary,
+// with numbers compared naturally, including those written with fullwidth
+// digits (see UnicodeDigits). Like the other locales, it implies
+// IgnoreAccents.
+//
+// Hiragana, katakana and halfwidth katakana of the same sound only differ
+// at the tertiary level, as do small and normal kana, while voiced and
+// semi-voiced sound marks (dakuten and handakuten) are secondary
+// differences. A prolonged sound mark ('ー') sorts like the vowel of the
+// kana before it, with a tertiary difference. For example, "あ2" < "ア10" <
+// "ｱ11" and "カーテン" < "かき" < "カキ" < "がき" < "かく".
+// Kanji are still ordered by code point, after all kana. With IgnoreCase,
+// tertiary differences are ignored, so hiragana and katakana are equal.
+var Japanese = Option{func(c *Collator) {
+	japanese().apply(c)
+	UnicodeDigits.apply(c)
+}}
+
+// japanese returns the locale option with the kana rules, which are only
+// generated when Japanese is first used.
+var japanese = sync.OnceValue(func() Option { return localeOption(japaneseRules()) })
+
+// japaneseRules returns the tailoring rules of Japanese.
+func japaneseRules() string {
+	var b strings.Builder
+	rule := func(reset, op, str string) {
+		b.WriteString("&" + reset + " " + op + " " + str + " ")
+	}
+	// hiragana returns the hiragana for a kana, and whether it has one.
+	hiragana := func(k rune) (rune, bool) {
+		if k >= 0xFF66 && k <= 0xFF9D { // Halfwidth katakana.
+			k = []rune(norm.NFKC.String(string(k)))[0]
+		}
+		switch {
+		case k >= 0x3041 && k <= 0x3096:
+			return k, true
+		case k >= 0x30A1 && k <= 0x30F6:
+			return k - 0x60, true
+		}
+		return 0, false
+	}
+	// A kana decomposes to its base kana and sound mark, if any.
+	decompose := func(k rune) (base rune, mark rune) {
+		d := []rune(norm.NFD.String(string(k)))
+		if len(d) == 2 {
+			return d[0], d[1]
+		}
+		return k, 0
+	}
+
+	// Voiced, semi-voiced and small hiragana.
+	for h := rune(0x3041); h <= 0x3096; h++ {
+		switch base, mark := decompose(h); {
+		case mark == voicedMark:
+			rule(string(base), "<<", string(h))
+		case mark == semiVoicedMark:
+			// After the voiced kana, if any.
+			reset := norm.NFC.String(string(base) + string(voicedMark))
+			rule(reset, "<<", string(h))
+		case normalKana(h) != h:
+			rule(string(normalKana(h)), "<<<", string(h))
+		}
+	}
+	// Katakana.
+	for k := rune(0x30A1); k <= 0x30FA; k++ {
+		if h, ok := hiragana(k); ok {
+			rule(string(h), "<<<", string(k))
+		} else if base, mark := decompose(k); mark == voicedMark {
+			// Like 'ヷ', which has no hiragana.
+			h, _ := hiragana(base)
+			rule(string(h), "<<", string(k))
+		}
+	}
+	// Halfwidth katakana, also with halfwidth sound marks.
+	halfwidth := make(map[rune]rune)
+	for hw := rune(0xFF66); hw <= 0xFF9D; hw++ {
+		k := []rune(norm.NFKC.String(string(hw)))[0]
+		halfwidth[k] = hw
+		rule(string(k), "<<<", string(hw))
+	}
+	for k := rune(0x30A1); k <= 0x30FA; k++ {
+		base, mark := decompose(k)
+		if hw, ok := halfwidth[base]; ok && mark != 0 {
+			rule(string(k), "<<<", string(hw)+string(halfwidthMark(mark)))
+		}
+	}
+	// Prolonged sound marks after all of them.
+	var kana []rune
+	for k := rune(0x3041); k <= 0x30FA; k++ {
+		if k < 0x3097 || k >= 0x30A1 {
+			kana = append(kana, k)
+		}
+	}
+	for k := rune(0xFF66); k <= 0xFF9D; k++ {
+		kana = append(kana, k)
+	}
+	for _, k := range kana {
+		h, ok := hiragana(k)
+		if !ok {
+			// Like 'ヷ'.
+			base, _ := decompose(k)
+			h, _ = hiragana(base)
+		}
+		base, _ := decompose(h)
+		vowel, ok := kanaVowel(normalKana(base))
+		if !ok {
+			continue
+		}
+		for _, mark := range []rune{'ー', 'ｰ'} {
+			rule(string(k)+string(vowel), "<<<", string(k)+string(mark))
+		}
+	}
+	return b.String()
+}
+
+// Combining sound marks, and their halfwidth forms.
+const (
+	voicedMark     = '゙'
+	semiVoicedMark = '゚'
+)
+
+// halfwidthMark returns the halfwidth form of a combining sound mark.
+func halfwidthMark(mark rune) rune {
+	if mark == semiVoicedMark {
+		return 'ﾟ'
+	}
+	return 'ﾞ'
+}
+
+// normalKana returns the normal hiragana for a small one, like 'あ' for 'ぁ',
+// and h itself for all others.
+func normalKana(h rune) rune {
+	switch h {
+	case 'ぁ', 'ぃ', 'ぅ', 'ぇ', 'ぉ', 'っ', 'ゃ', 'ゅ', 'ょ', 'ゎ':
+		return h + 1
+	case 'ゕ':
+		return 'か'
+	case 'ゖ':
+		return 'け'
+	}
+	return h
+}
+
+// kanaVowel returns the vowel of a hiragana, which a prolonged sound mark
+// after it repeats, and whether it has one.
+func kanaVowel(h rune) (rune, bool) {
+	// Rows of the gojūon table, with '・' for the gaps.
+	for _, row := range []string{
+		"あいうえお", "かきくけこ", "さしすせそ", "たちつてと", "なにぬねの",
+		"はひふへほ", "まみむめも", "や・ゆ・よ", "らりるれろ", "わゐ・ゑを",
+	} {
+		for i, k := range []rune(row) {
+			if k == h && k != '・' {
+				return []rune("あいうえお")[i], true
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,75 @@
+package collate
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestJapanese(t *testing.T) {
+	c := NewCollator(Japanese)
+	for _, want := range [][]string{
+		{"あ2", "ア10", "ｱ11"},
+		{"カーテン", "かき", "カキ", "がき", "かく"},
+		{"は", "ハ", "ば", "バ", "ぱ", "パ", "ひ"},
+		{"つ", "ツ", "っ", "ッ", "づ"},
+		{"ｶ", "ｶﾞ", "ｷ"},
+		{"第２章", "第3章", "第１０章"},
+		{"ア", "ん", "亜"},
+	} {
+		got := make([]string, len(want))
+		for i := range got {
+			got[i] = want[len(want)-1-i]
+		}
+		sort.Sort(c.Sorter(got))
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("Error: sort failed, expected: %#q, got: %#q", want, got)
+		}
+		checkStrictWeakOrder(t, c, want)
+	}
+
+	testset := []struct {
+		opts   []Option
+		s1, s2 string
+		cmp    int
+	}{
+		// Hiragana and katakana only differ at the tertiary level.
+		{nil, "あ2", "ア10", -1},
+		{nil, "ア", "あ", +1},
+		{[]Option{Strength(Primary)}, "あいうえお", "アイウエオ", 0},
+		{[]Option{Strength(Secondary)}, "あいうえお", "ｱｲｳｴｵ", 0},
+		{[]Option{Strength(Secondary)}, "ぁ", "あ", 0},
+		// Sound marks are secondary differences.
+		{[]Option{Strength(Primary)}, "がっこう", "カッコウ", 0},
+		{[]Option{Strength(Secondary)}, "がっこう", "カッコウ", +1},
+		{[]Option{Strength(Secondary)}, "ｶﾞｲﾄﾞ", "ガイド", 0},
+		{[]Option{Strength(Secondary)}, "ｶﾞｲﾄﾞ", "カイト", +1},
+		{[]Option{Strength(Secondary)}, "ヴ", "ゔ", 0},
+		{[]Option{Strength(Secondary)}, "ｳﾞ", "ゔ", 0},
+		{[]Option{Strength(Secondary)}, "ヷ", "わ", +1},
+		{[]Option{Strength(Primary)}, "ヷ", "わ", 0},
+		{[]Option{Strength(Secondary)}, "が", "が", 0},
+		// Prolonged sound marks repeat the vowel.
+		{[]Option{Strength(Secondary)}, "カー", "かあ", 0},
+		{[]Option{Strength(Secondary)}, "ｶｰ", "カア", 0},
+		{[]Option{Strength(Secondary)}, "キー", "きい", 0},
+		{[]Option{Strength(Secondary)}, "ギョー", "ぎょお", 0},
+		{[]Option{Strength(Secondary)}, "ボール", "ぼおる", 0},
+		{nil, "カー", "カア", -1},
+		{nil, "カード", "カオ", -1},
+		{nil, "ー", "あ", +1},
+		// Fullwidth digits are natural numbers.
+		{nil, "ファイル２", "ファイル10", -1},
+		{nil, "ファイル１０", "ファイル9", +1},
+		{nil, "ファイル１０", "ファイル10", +1},
+		{[]Option{Strength(Tertiary)}, "ファイル１０", "ファイル10", 0},
+		// Like case, kana types are ignored with IgnoreCase.
+		{[]Option{IgnoreCase}, "データA", "でーたa", 0},
+	}
+	for _, v := range testset {
+		c := NewCollator(append([]Option{Japanese}, v.opts...)...)
+		if got := c.Compare(v.s1, v.s2); got != v.cmp {
+			t.Errorf("Compared %#q to %#q: expected %v, got %v", v.s1, v.s2, v.cmp, got)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package collate
+
+import (
+	"unicode/utf8"
+
+	"github.com/fvbommel/sortorder"
+	"github.com/fvbommel/sortorder/internal/sortkey"
+)
+
+// KeyVersion is the version of the sort key format produced by AppendKey.
+// It is incremented like sortorder.KeyVersion whenever the format changes.
+//
+// Note that keys also depend on Unicode data that is not part of this
+// package: case, accents, character classes and scripts come from the
+// Unicode tables of the Go version used to build the program, and
+// decompositions (see Normalize and IgnoreAccents) from the normalization
+// tables of the golang.org/x/text version in use. Upgrading either may
+// change the keys of strings containing characters that were added to or
+// changed in Unicode since, so stored keys may have to be rebuilt.
+const KeyVersion = 1
+
+// Key returns a sort key for s. See AppendKey for details.
+func (c *Collator) Key(s string) []byte {
+	return c.AppendKey(nil, s)
+}
+
+// AppendKey appends a sort key for s to dst and returns the extended buffer.
+//
+// Sort keys compare bytewise in the order of the collator: for any strings
+// a and b, bytes.Compare(c.Key(a), c.Key(b)) == c.Compare(a, b).
+// A collator without options produces the same keys as sortorder.AppendKey.
+// Otherwise, the key format depends on the options, so keys created by
+// collators with different options must not be compared with each other.
+// The format will not change without KeyVersion being incremented.
+func (c *Collator) AppendKey(dst []byte, str string) []byte {
+	if c.isDefault() {
+		return sortorder.AppendKey(dst, str)
+	}
+	first, last := c.levels()
+	dst = c.appendKeyTokens(dst, c.scanner(str, first))
+	for level := first + 1; level <= last; level++ {
+		if !c.sameLevels(level-1, level) {
+			dst = append(dst, keyLevel)
+			dst = c.appendKeyTokens(dst, c.scanner(str, level))
+		}
+	}
+	if c.strength != 0 && c.strength < Identical {
+		return dst
+	}
+	if c.unicodeDigits || c.signed {
+		// Equivalent strings are ordered by the way their numbers are
+		// written. Otherwise, equal numbers are always written the same way.
+		dst = append(dst, keyLevel)
+		s := c.scanner(str, last)
+		for tok, ok := s.next(); ok; tok, ok = s.next() {
+			if !tok.number {
+				continue
+			}
+			if c.zeros != ZerosIgnored {
+				dst = appendKeyText(dst, tok.text)
+			} else {
+				dst = appendKeyText(dst, tok.signText)
+				dst = appendKeyText(dst, tok.digitText)
+			}
+		}
+	}
+	if c.strength == Identical {
+		dst = append(dst, keyLevel)
+		dst = append(dst, str...)
+	}
+	return dst
+}
+
+// appendKeyTokens appends the primary weights of the tokens of s.
+func (c *Collator) appendKeyTokens(dst []byte, s scanner) []byte {
+	for tok, ok := s.next(); ok; tok, ok = s.next() {
+		if c.ordersClasses() {
+			// Ranks start at 1, so they're greater than keyLevel.
+			dst = append(dst, byte(c.classRank(&tok)))
+		}
+		if tok.number {
+			dst = c.appendKeyNumber(dst, &tok)
+		} else {
+			if c.scripts != nil {
+				dst = append(dst, byte(keyRuneOffset+c.scripts.rank(tok.r)))
+			}
+			if s.orderCase {
+				dst = append(dst, byte(keyRuneOffset+c.caseRank(tok.r)))
+			}
+			dst = appendKeyRune(dst, tok.r)
+			if c.tailoring != nil {
+				dst = s.appendKeyWeight(dst, tok.w)
+			}
+		}
+	}
+	return dst
+}
+
+// appendKeyText appends the encoding of text, followed by a terminator.
+func appendKeyText(dst []byte, text string) []byte {
+	for i := 0; i < len(text); i++ {
+		dst = append(dst, text[i]+1)
+	}
+	return append(dst, 0)
+}
+
+// Collator keys start with the primary weights of all tokens, optionally
+// followed by keyLevel and tie-breaking information.
+// Numbers start with the marker for their sign, while characters start with
+// a byte greater than any of these markers.
+const (
+	keyLevel = iota
+	keyNegative
+	keyZero
+	keyPositive
+	keyRuneOffset
+)
+
+// appendKeyRune appends the collator key encoding of the character r.
+func appendKeyRune(dst []byte, r rune) []byte {
+	if r >= invalidRune {
+		return append(dst, 0xFF, byte(r-invalidRune))
+	}
+	var buf [utf8.UTFMax]byte
+	for _, b := range buf[:utf8.EncodeRune(buf[:], r)] {
+		dst = append(dst, b+keyRuneOffset)
+	}
+	return dst
+}
+
+// keyVersion marks the first part of a version number in a key. It is
+// greater than the encoding of any fractional digit.
+const keyVersion = 11
+
+// appendKeyNumber appends the collator key encoding of a number token.
+func (c *Collator) appendKeyNumber(dst []byte, tok *token) []byte {
+	switch tok.sign() {
+	case -1:
+		dst = append(dst, keyNegative)
+	case 0:
+		dst = append(dst, keyZero)
+	case +1:
+		dst = append(dst, keyPositive)
+	}
+	if tok.sign() != 0 {
+		start := len(dst)
+		dst = sortkey.AppendCount(dst, tok.ndigits)
+		for _, r := range tok.digits {
+			dst = append(dst, byte(digitValue(r)))
+		}
+		if c.hasFractions() {
+			// Version numbers follow all fractional parts.
+			if tok.version {
+				dst = append(dst, keyVersion)
+			}
+			// Trailing zeros don't change the value.
+			frac := len(dst)
+			for _, r := range tok.frac {
+				dst = append(dst, byte(digitValue(r)+1))
+			}
+			for len(dst) > frac && dst[len(dst)-1] == 1 {
+				dst = dst[:len(dst)-1]
+			}
+			dst = append(dst, 0)
+		}
+		if tok.neg {
+			// The larger the magnitude, the smaller the negative number.
+			for i := start; i < len(dst); i++ {
+				dst[i] = ^dst[i]
+			}
+		}
+	}
+	if c.zeros != ZerosIgnored {
+		start := len(dst)
+		dst = sortkey.AppendCount(dst, tok.zeros)
+		if c.zeros == MoreZerosFirst {
+			for i := start; i < len(dst); i++ {
+				dst[i] = ^dst[i]
+			}
+		}
+	}
+	if c.hasFractions() {
+		dst = sortkey.AppendCount(dst, utf8.RuneCountInString(tok.frac))
+	}
+	return dst
+}
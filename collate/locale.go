@@ -0,0 +1,81 @@
+package collate
+
+import "sync"
+
+// The options below tailor a Collator to the alphabet of a language, as
+// described in the Unicode Common Locale Data Repository (CLDR). Like other
+// tailorings (see Tailor), they only change the order of letters, so
+// numbers are still compared naturally, and they can be combined with other
+// options, like IgnoreCase. They also imply IgnoreAccents, so accents that
+// aren't part of the alphabet only break ties, as in dictionaries.
+var (
+	// German sorts letters with umlauts like the letters without them, as
+	// in dictionaries, and 'ß' like "ss". The umlauts only break ties.
+	// For example, "Apfel" < "Äpfel 2" < "Äpfel 10" < "Aprikose" and
+	// "Masse" < "Maße" < "Massen".
+	German = localeOption("&a << ä &o << ö &u << ü &ss << ß")
+
+	// GermanPhonebook sorts letters with umlauts like the letter followed by
+	// 'e', as in phone books, and 'ß' like "ss".
+	// For example, "Mueller" < "Müller" < "Muffler".
+	GermanPhonebook = localeOption("&ae << ä &oe << ö &ue << ü &ss << ß")
+
+	// Swedish sorts 'å', 'ä' and 'ö' as separate letters after 'z', with 'æ'
+	// like 'ä' and 'ø' like 'ö', and 'ü' like 'y'.
+	// For example, "zon" < "ål" < "äng" < "ö 2" < "ö 10".
+	Swedish = localeOption("&z < å < ä << æ < ö << ø &y << ü")
+
+	// Danish sorts 'æ', 'ø' and 'å' as separate letters after 'z', with 'ä'
+	// like 'æ', 'ö' like 'ø', "aa" like 'å' and 'ü' like 'y'.
+	// For example, "zebra" < "æble" < "ørn" < "aabenraa" < "ål".
+	Danish = localeOption(danishRules)
+
+	// Norwegian sorts letters like Danish.
+	Norwegian = localeOption(danishRules)
+
+	// Spanish sorts 'ñ' as a separate letter after 'n'.
+	// For example, "nube" < "ñandú" < "oca".
+	Spanish = localeOption("&n < ñ")
+
+	// TraditionalSpanish also sorts "ch" and "ll" as separate letters after
+	// 'c' and 'l'. For example, "cuna" < "chico" < "dedo" and
+	// "luz" < "llama" < "mar".
+	TraditionalSpanish = localeOption("&c < ch &l < ll &n < ñ")
+
+	// Polish sorts the letters with diacritics as separate letters after
+	// the letters without them.
+	// For example, "lato" < "łąka" < "mama" and "zero" < "źle" < "żaba".
+	Polish = localeOption("&a < ą &c < ć &e < ę &l < ł &n < ń &o < ó &s < ś &z < ź < ż")
+
+	// Czech sorts 'č', 'ř', 'š' and 'ž' as separate letters after the
+	// letters without them, and "ch" as a separate letter after 'h'. The
+	// other letters with diacritics only break ties.
+	// For example, "cukr" < "čaj" < "hrad" < "chata" < "ibis".
+	Czech = localeOption("&a << á &c < č &d << ď &e << é << ě &h < ch &i << í &n << ň " +
+		"&o << ó &r < ř &s < š &t << ť &u << ú << ů &y << ý &z < ž")
+
+	// Lithuanian sorts 'y' right after 'i', and 'č', 'š' and 'ž' as separate
+	// letters after the letters without them. The other letters with
+	// diacritics only break ties.
+	// For example, "ilgas" < "yra" < "jūra" and "sakė" < "šalis" < "tas".
+	Lithuanian = localeOption("&i < y &c < č &s < š &z < ž &a << ą &e << ę << ė &i << į &u << ų << ū")
+
+	// Estonian sorts 'š', 'z' and 'ž' right after 's', and 'õ', 'ä', 'ö'
+	// and 'ü' after 'w'.
+	// For example, "sõna" < "šokk" < "zoo" < "tee" and "wc" < "õun" < "ära".
+	Estonian = localeOption("&s < š < z < ž &w < õ < ä < ö < ü")
+)
+
+// localeOption returns an Option that applies the given tailoring rules and
+// IgnoreAccents. The rules are parsed when the Option is first used, so
+// programs that don't use it don't pay for them.
+func localeOption(rules string) Option {
+	tailor := sync.OnceValue(func() Option { return MustTailor(rules) })
+	return Option{func(c *Collator) {
+		tailor().apply(c)
+		IgnoreAccents.apply(c)
+	}}
+}
+
+// danishRules holds the rules of Danish and Norwegian.
+const danishRules = "&z < æ << ä < ø << ö < å <<< aa &y << ü"
@@ -0,0 +1,92 @@
+package collate
+
+import (
+	"bytes"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestLocales(t *testing.T) {
+	testset := []struct {
+		name string
+		opt  Option
+		want []string
+	}{
+		{"German", German, []string{"Apfel", "Äpfel 2", "Äpfel 10", "Aprikose"}},
+		{"German", German, []string{"Masse", "Maße", "Massen"}},
+		{"German", German, []string{"schon", "schön", "schule", "süß", "tür"}},
+		{"GermanPhonebook", GermanPhonebook, []string{"Mueller", "Müller", "Muffler"}},
+		{"GermanPhonebook", GermanPhonebook, []string{"Goethe", "Göthe", "Götz", "Gold"}},
+		{"Swedish", Swedish, []string{"zon", "ål", "äng", "æter", "ö 2", "ö 10", "øre", "öst"}},
+		{"Swedish", Swedish, []string{"über", "yxa", "zon"}},
+		{"Danish", Danish, []string{"zebra", "æble", "ørn", "aabenraa", "ål"}},
+		{"Danish", Danish, []string{"æble", "ähnlich", "ære", "ø 2", "ö 3", "ø 10"}},
+		{"Norwegian", Norwegian, []string{"zebra", "æble", "ørn", "aa", "å1", "å2", "aa10"}},
+		{"Spanish", Spanish, []string{"nube", "ñandú", "oca"}},
+		{"Spanish", Spanish, []string{"canto", "caña", "cañón", "caos"}},
+		{"Spanish", Spanish, []string{"chico", "cuna", "dedo", "llama", "luz"}},
+		{"TraditionalSpanish", TraditionalSpanish, []string{"cuna", "chico", "dedo", "luz", "llama", "mar"}},
+		{"Polish", Polish, []string{"lato", "łąka", "mama"}},
+		{"Polish", Polish, []string{"zero", "źle", "żaba"}},
+		{"Polish", Polish, []string{"osa", "ów", "pole", "sok", "ślad", "tak"}},
+		{"Czech", Czech, []string{"cukr", "čaj", "hrad", "chata", "ibis"}},
+		{"Czech", Czech, []string{"kapitola 2", "kapitola 10", "kapitola ř"}},
+		{"Czech", Czech, []string{"dum", "dům", "duma", "rak", "řeka", "sud", "šála", "zub", "žena"}},
+		{"Lithuanian", Lithuanian, []string{"ilgas", "yra", "jūra"}},
+		{"Lithuanian", Lithuanian, []string{"sakė", "šalis", "tas"}},
+		{"Estonian", Estonian, []string{"sõna", "šokk", "zoo", "tee"}},
+		{"Estonian", Estonian, []string{"wc", "õun", "ära", "öö", "üks"}},
+	}
+	for _, v := range testset {
+		c := NewCollator(v.opt)
+		got := make([]string, len(v.want))
+		for i := range got {
+			got[i] = v.want[len(got)-1-i]
+		}
+		sort.Sort(c.Sorter(got))
+		if !reflect.DeepEqual(v.want, got) {
+			t.Errorf("%s: sort failed, expected: %#q, got: %#q", v.name, v.want, got)
+		}
+		checkStrictWeakOrder(t, c, v.want)
+		for i := 1; i < len(v.want); i++ {
+			if bytes.Compare(c.Key(v.want[i-1]), c.Key(v.want[i])) >= 0 {
+				t.Errorf("%s: key of %#q is not less than that of %#q", v.name, v.want[i-1], v.want[i])
+			}
+		}
+	}
+}
+
+func TestLocalesWithOptions(t *testing.T) {
+	testset := []struct {
+		opts   []Option
+		s1, s2 string
+		cmp    int
+	}{
+		{[]Option{Spanish, IgnoreCase}, "Ñandú", "nube", +1},
+		{[]Option{Spanish, IgnoreCase}, "ÑANDÚ", "ñandú", 0},
+		{[]Option{Spanish}, "Ñandú", "nube", -1},
+		{[]Option{Swedish, IgnoreCase}, "Åsa", "zon", +1},
+		{[]Option{Danish, IgnoreCase}, "Aabenraa", "ål", -1},
+		{[]Option{Danish, IgnoreCase}, "Aa", "å", 0},
+		{[]Option{Danish, IgnoreCase, Strength(Identical)}, "Aa", "å", -1},
+		{[]Option{Danish}, "aa", "å", +1},
+		{[]Option{Czech, IgnoreCase}, "Chata", "hrad", +1},
+		{[]Option{Czech, IgnoreCase}, "CHATA", "chata", 0},
+		{[]Option{German, Normalize(Canonical)}, "A\u0308pfel", "Äpfel", 0},
+		{[]Option{German}, "A\u0308pfel", "Apfel", +1},
+		{[]Option{German}, "A\u0308pfel", "Aprikose", -1},
+		{[]Option{German, Strength(Primary)}, "Maße", "Masse", 0},
+		{[]Option{German, UnicodeDigits}, "Äpfel ٢", "Apfel 10", -1},
+		{[]Option{Polish, DecimalFractions}, "ó 1.5", "ó 1.25", +1},
+		// Later rules insert letters right after the reset.
+		{[]Option{Estonian, MustAlphabet("w", "x")}, "õ", "x", +1},
+		{[]Option{Estonian, MustAlphabet("w", "x")}, "x", "y", -1},
+	}
+	for _, v := range testset {
+		c := NewCollator(v.opts...)
+		if got := c.Compare(v.s1, v.s2); got != v.cmp {
+			t.Errorf("Compared %#q to %#q: expected %v, got %v", v.s1, v.s2, v.cmp, got)
+		}
+	}
+}
@@ -0,0 +1,443 @@
+package collate
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Tailor returns an Option that changes the order of characters according to
+// the given rules, which use a subset of the syntax of ICU and CLDR
+// tailoring rules. For example, "&n < ñ" sorts 'ñ' as a separate letter
+// between 'n' and 'o', and "&z < æ < ø < å" sorts "æøå" after 'z', in that
+// order.
+//
+// A rule starts with '&' and the string to reset the position to, followed
+// by any number of relations. Each relation is an operator and a string,
+// which is sorted right after the previous one:
+//   - '<' sorts it as a different letter (a primary difference),
+//   - "<<" as the same letter with a different accent (secondary),
+//   - "<<<" as the same letter in a different case (tertiary),
+//   - '=' as equal.
+//
+// Strings are separated by white space, which is otherwise ignored, and a
+// backslash escapes the character after it.
+// A string of several characters, like "ch" in "&c < ch", is sorted as a
+// single letter (a contraction). A reset to several characters, like "ae"
+// in "&ae << ä", sorts the strings after it like those characters
+// (an expansion).
+// Digits, including those of other scripts (Unicode category Nd), can't be
+// tailored, so numbers are still compared naturally, also with UnicodeDigits.
+//
+// As in the Unicode Collation Algorithm, secondary and tertiary differences
+// only count if the strings are equal otherwise, and the former before the
+// latter. So with "&a << ä", "äa" < "ab", and "ä2" < "a10".
+// Strength(Primary) ignores both, while IgnoreCase and Strength(Secondary)
+// ignore tertiary differences.
+//
+// Tailored strings are matched case-sensitively, but their upper-case and
+// title-case variants are sorted like them unless they are tailored too,
+// relative to the upper-case variant of the reset. So "&n < ñ" also sorts
+// 'Ñ' between 'N' and 'O', and "&c < ch" sorts "Ch" and "CH" right after
+// 'C'. Composed and decomposed forms (NFC and NFD) are both matched.
+//
+// The rules are compiled when the Option is first used, and collators
+// created with it share the result. Using Tailor or Alphabet more than once
+// applies all of their rules, in order. If the rules can't be parsed, Tailor
+// returns an error and the zero Option, which has no effect.
+func Tailor(rules string) (Option, error) {
+	parsed, err := parseRules(rules)
+	if err != nil {
+		return Option{}, err
+	}
+	return tailorOption(parsed), nil
+}
+
+// MustTailor is like Tailor, but panics if the rules can't be parsed.
+// It simplifies the initialization of global variables holding collators.
+func MustTailor(rules string) Option {
+	opt, err := Tailor(rules)
+	if err != nil {
+		panic(err)
+	}
+	return opt
+}
+
+// Alphabet returns an Option that sorts the given letters in the given
+// order, after the first one. For example, Alphabet("z", "æ", "ø", "å") is
+// equivalent to Tailor("&z < æ < ø < å"). Letters may consist of several
+// characters, like the "ch" of Alphabet("c", "ch", "d").
+// As with Tailor, letters can't contain digits or invalid UTF-8; if they do,
+// Alphabet returns an error and the zero Option, which has no effect.
+func Alphabet(letters ...string) (Option, error) {
+	var r rule
+	for _, letter := range letters {
+		for i := 0; i < len(letter); {
+			c, size := utf8.DecodeRuneInString(letter[i:])
+			if err := checkRune(c, size); err != nil {
+				return Option{}, fmt.Errorf("collate: invalid letter %+q: %v", letter, err)
+			}
+			i += size
+		}
+		switch {
+		case letter == "":
+		case r.reset == "":
+			r.reset = letter
+		default:
+			r.rels = append(r.rels, relation{Primary, letter})
+		}
+	}
+	if len(r.rels) == 0 {
+		return tailorOption(nil), nil
+	}
+	return tailorOption([]rule{r}), nil
+}
+
+// MustAlphabet is like Alphabet, but panics if a letter is invalid.
+func MustAlphabet(letters ...string) Option {
+	opt, err := Alphabet(letters...)
+	if err != nil {
+		panic(err)
+	}
+	return opt
+}
+
+// tailorOption returns an Option that adds the given rules to a Collator.
+func tailorOption(rules []rule) Option {
+	// Collators with a single tailoring share it.
+	built := sync.OnceValue(func() *tailoring { return newTailoring(rules) })
+	return Option{func(c *Collator) {
+		switch {
+		case len(rules) == 0:
+		case c.tailoring == nil:
+			c.tailoring = built()
+		default:
+			all := slices.Clip(c.tailoring.rules)
+			c.tailoring = newTailoring(append(all, rules...))
+		}
+	}}
+}
+
+// A rule is a parsed tailoring rule.
+type rule struct {
+	reset string
+	rels  []relation
+}
+
+// A relation sorts str right after the previous string of its rule, with a
+// difference at the given level. Identical means no difference.
+type relation struct {
+	level Level
+	str   string
+}
+
+// parseRules parses tailoring rules. See Tailor for the syntax.
+func parseRules(rules string) ([]rule, error) {
+	var parsed []rule
+	p := ruleParser{src: rules}
+	for p.skipSpace(); p.pos < len(p.src); p.skipSpace() {
+		if p.src[p.pos] != '&' {
+			return nil, p.errorf("expected '&'")
+		}
+		p.pos++
+		r := rule{reset: p.str()}
+		if r.reset == "" {
+			return nil, p.errorf("missing string after '&'")
+		}
+		for p.skipSpace(); p.pos < len(p.src) && p.src[p.pos] != '&'; p.skipSpace() {
+			level := p.operator()
+			if level == 0 {
+				return nil, p.errorf("expected '<', \"<<\", \"<<<\" or '='")
+			}
+			str := p.str()
+			if str == "" {
+				return nil, p.errorf("missing string after operator")
+			}
+			r.rels = append(r.rels, relation{level, str})
+		}
+		if len(r.rels) == 0 {
+			return nil, p.errorf("missing relation after %q", r.reset)
+		}
+		parsed = append(parsed, r)
+	}
+	return parsed, p.err
+}
+
+// A ruleParser holds the state of parseRules.
+type ruleParser struct {
+	src string
+	pos int
+	err error
+}
+
+func (p *ruleParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("collate: invalid tailoring at offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *ruleParser) skipSpace() {
+	for p.pos < len(p.src) {
+		r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		if !unicode.IsSpace(r) {
+			return
+		}
+		p.pos += size
+	}
+}
+
+// operator parses a relation operator, and returns its level, or 0 if there
+// is none.
+func (p *ruleParser) operator() Level {
+	switch {
+	case strings.HasPrefix(p.src[p.pos:], "<<<"):
+		p.pos += 3
+		return Tertiary
+	case strings.HasPrefix(p.src[p.pos:], "<<"):
+		p.pos += 2
+		return Secondary
+	case strings.HasPrefix(p.src[p.pos:], "<"):
+		p.pos++
+		return Primary
+	case strings.HasPrefix(p.src[p.pos:], "="):
+		p.pos++
+		return Identical
+	}
+	return 0
+}
+
+// str parses a string, which may be empty.
+func (p *ruleParser) str() string {
+	p.skipSpace()
+	var b strings.Builder
+	for p.pos < len(p.src) {
+		r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		switch {
+		case r == '\\' && p.pos+size < len(p.src):
+			p.pos += size
+			r, size = utf8.DecodeRuneInString(p.src[p.pos:])
+		case unicode.IsSpace(r) || r == '&' || r == '<' || r == '=':
+			return b.String()
+		}
+		if err := checkRune(r, size); err != nil && p.err == nil {
+			p.err = p.errorf("%v", err)
+		}
+		b.WriteRune(r)
+		p.pos += size
+	}
+	return b.String()
+}
+
+// checkRune returns an error if the character r, which takes up size bytes,
+// can't be tailored. Digits (Unicode category Nd) can't, because they may
+// start a number with UnicodeDigits.
+func checkRune(r rune, size int) error {
+	switch {
+	case r == utf8.RuneError && size == 1:
+		return errors.New("invalid UTF-8")
+	case unicode.IsDigit(r):
+		return errors.New("digits can't be tailored")
+	}
+	return nil
+}
+
+// A tailoring holds the collation elements of tailored strings.
+// It is immutable once built, so it can be shared by collators.
+type tailoring struct {
+	rules []rule
+	// byFirst holds the tailored strings by their first character, longest
+	// first.
+	byFirst map[rune][]tailored
+}
+
+// A tailored string is compared as a sequence of collation elements.
+type tailored struct {
+	str   string
+	elems []element
+}
+
+// An element is compared like its character r, and then by its weight.
+// Characters that aren't tailored have a zero weight.
+type element struct {
+	r rune
+	w weight
+}
+
+// A weight orders tailored strings sorted after the same character, at
+// the primary, secondary and tertiary level.
+type weight struct {
+	p, s, t uint16
+}
+
+// A tailoredEntry is a tailored string while the tailoring is built.
+type tailoredEntry struct {
+	str   string
+	level Level
+	// prefix holds the strings the entry expands to before its last element,
+	// and anchor the character that element is sorted after.
+	prefix []string
+	anchor rune
+	w      weight
+}
+
+// newTailoring builds a tailoring from rules.
+func newTailoring(rules []rule) *tailoring {
+	entries := make(map[string]*tailoredEntry)
+	// lists holds the entries sorted after each anchor, in order.
+	lists := make(map[rune][]*tailoredEntry)
+	for _, r := range rules {
+		var prefix []string
+		var anchor rune
+		var after *tailoredEntry
+		if e, ok := entries[r.reset]; ok {
+			prefix, anchor, after = e.prefix, e.anchor, e
+		} else {
+			runes := []rune(r.reset)
+			for _, c := range runes[:len(runes)-1] {
+				prefix = append(prefix, string(c))
+			}
+			anchor = runes[len(runes)-1]
+			if e, ok := entries[string(anchor)]; ok {
+				prefix, anchor, after = append(prefix, e.prefix...), e.anchor, e
+			}
+		}
+		for _, rel := range r.rels {
+			if old, ok := entries[rel.str]; ok {
+				list := lists[old.anchor]
+				i := slices.Index(list, old)
+				lists[old.anchor] = slices.Delete(list, i, i+1)
+			}
+			e := &tailoredEntry{str: rel.str, level: rel.level, prefix: prefix, anchor: anchor}
+			list := lists[anchor]
+			// Insert right after the previous entry, but after the entries
+			// with smaller differences that follow it.
+			i := slices.Index(list, after) + 1
+			for i < len(list) && list[i].level > rel.level {
+				i++
+			}
+			lists[anchor] = slices.Insert(list, i, e)
+			entries[rel.str] = e
+			after = e
+		}
+	}
+
+	for _, list := range lists {
+		var w weight
+		for _, e := range list {
+			switch e.level {
+			case Primary:
+				w = weight{p: w.p + 1}
+			case Secondary:
+				w = weight{p: w.p, s: w.s + 1}
+			case Tertiary:
+				w.t++
+			}
+			e.w = w
+		}
+	}
+
+	t := &tailoring{rules: rules, byFirst: make(map[rune][]tailored)}
+	resolving := make(map[string]bool)
+	var resolve func(str string) []element
+	resolve = func(str string) []element {
+		e, ok := entries[str]
+		if !ok || resolving[str] {
+			var elems []element
+			for _, r := range str {
+				elems = append(elems, element{r: r})
+			}
+			return elems
+		}
+		resolving[str] = true
+		defer delete(resolving, str)
+		var elems []element
+		for _, p := range e.prefix {
+			elems = append(elems, resolve(p)...)
+		}
+		return append(elems, element{e.anchor, e.w})
+	}
+	strs := make([]string, 0, len(entries))
+	for str := range entries {
+		strs = append(strs, str)
+	}
+	sort.Strings(strs)
+	added := make(map[string]bool)
+	add := func(str string, elems []element) {
+		for _, str := range []string{str, norm.NFC.String(str), norm.NFD.String(str)} {
+			if added[str] {
+				continue
+			}
+			added[str] = true
+			r, _ := utf8.DecodeRuneInString(str)
+			t.byFirst[r] = append(t.byFirst[r], tailored{str, elems})
+		}
+	}
+	for _, str := range strs {
+		add(str, resolve(str))
+	}
+	// Case variants are only added if they aren't tailored themselves.
+	for _, str := range strs {
+		elems := resolve(str)
+		if upper := strings.ToUpper(str); !added[upper] {
+			upperElems := make([]element, len(elems))
+			for i, e := range elems {
+				upperElems[i] = element{unicode.ToUpper(e.r), e.w}
+			}
+			add(upper, upperElems)
+		}
+		first, size := utf8.DecodeRuneInString(str)
+		if title := string(unicode.ToTitle(first)) + str[size:]; !added[title] {
+			titleElems := slices.Clone(elems)
+			titleElems[0].r = unicode.ToUpper(titleElems[0].r)
+			add(title, titleElems)
+		}
+	}
+	for _, list := range t.byFirst {
+		sort.SliceStable(list, func(i, j int) bool { return len(list[i].str) > len(list[j].str) })
+	}
+	return t
+}
+
+// match returns the longest tailored string that str starts with.
+func (t *tailoring) match(str string) (tailored, bool) {
+	r, _ := utf8.DecodeRuneInString(str)
+	for _, cand := range t.byFirst[r] {
+		if strings.HasPrefix(str, cand.str) {
+			return cand, true
+		}
+	}
+	return tailored{}, false
+}
+
+// compareWeights compares the weights of two elements with the same
+// character, up to the weight level of s.
+func (s *scanner) compareWeights(w1, w2 weight) int {
+	switch {
+	case w1.p != w2.p:
+		return cmp.Compare(w1.p, w2.p)
+	case s.weights >= Secondary && w1.s != w2.s:
+		return cmp.Compare(w1.s, w2.s)
+	case s.weights >= Tertiary && w1.t != w2.t:
+		return cmp.Compare(w1.t, w2.t)
+	}
+	return 0
+}
+
+// appendKeyWeight appends the key encoding of a weight, up to the weight
+// level of s.
+func (s *scanner) appendKeyWeight(dst []byte, w weight) []byte {
+	dst = append(dst, byte(w.p>>8), byte(w.p))
+	if s.weights >= Secondary {
+		dst = append(dst, byte(w.s>>8), byte(w.s))
+	}
+	if s.weights >= Tertiary {
+		dst = append(dst, byte(w.t>>8), byte(w.t))
+	}
+	return dst
+}
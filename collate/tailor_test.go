@@ -0,0 +1,233 @@
+package collate
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestCollatorTailor(t *testing.T) {
+	c := NewCollator(MustTailor("&n < ñ"), IgnoreCase)
+	want := []string{"nube", "Nuño 2", "nuño 10", "ñandú", "Ñu", "oca"}
+	got := []string{"ñandú", "oca", "nuño 10", "Ñu", "Nuño 2", "nube"}
+	sort.Sort(c.Sorter(got))
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Error: sort failed, expected: %#q, got: %#q", want, got)
+	}
+
+	testset := []struct {
+		rules  string
+		s1, s2 string
+		cmp    int
+	}{
+		{"&n < ñ", "ñ", "nz", +1},
+		{"&n < ñ", "ñ", "o", -1},
+		{"&n < ñ", "Ñ", "N", +1},
+		{"&n < ñ", "Ñ", "O", -1},
+		{"&n < ñ", "ñ", "ñ", 0},
+		{"&z < æ < ø < å", "zz", "æ", -1},
+		{"&z < æ < ø < å", "æ", "ø", -1},
+		{"&z < æ < ø < å", "ø", "å", -1},
+		{"&z < æ < ø < å", "Å", "Ø", +1},
+		{"&z < æ < ø < å", "å1", "å01", -1},
+		{"&z < æ < ø < å", "å2", "å10", -1},
+		// Relations are inserted after smaller differences.
+		{"&a < b &a < c", "c", "b", -1},
+		{"&a < c &a << b", "b", "c", -1},
+		{"&a << b", "b", "c", -1},
+		{"&a << b", "b", "a", +1},
+		{"&a << b", "b", "aa", -1},
+		{"&a = b", "ab", "ba", 0},
+		// Tailoring a string again moves it.
+		{"&z < b &a < b", "b", "c", -1},
+		{"&z < b", "b", "c", +1},
+		// Contractions and expansions.
+		{"&c < ch", "cz", "ch", -1},
+		{"&c < ch", "ch", "d", -1},
+		{"&c < ch", "Ch", "CZ", +1},
+		{"&ae << ä", "ä", "ae", +1},
+		{"&ae << ä", "ä", "af", -1},
+		{"&ae << ä", "äz", "aez", +1},
+		// Escapes.
+		{`&\< < \&`, "&", "<=", +1},
+		{`&\< < \&`, "&", "=", -1},
+	}
+	for _, v := range testset {
+		c := NewCollator(MustTailor(v.rules))
+		if got := c.Compare(v.s1, v.s2); got != v.cmp {
+			t.Errorf("%s: compared %#q to %#q: expected %v, got %v", v.rules, v.s1, v.s2, v.cmp, got)
+		}
+	}
+}
+
+func TestCollatorTailorLevels(t *testing.T) {
+	rules := MustTailor("&a << ä <<< Ä < b &ae << æ")
+	testset := []struct {
+		opts   []Option
+		s1, s2 string
+		cmp    int
+	}{
+		{nil, "a", "ä", -1},
+		{nil, "ä", "Ä", -1},
+		{nil, "Ä", "b", -1},
+		{[]Option{IgnoreAccents}, "a", "ä", -1},
+		{[]Option{IgnoreAccents}, "ä2", "a10", -1},
+		{[]Option{IgnoreAccents}, "ä", "b", -1},
+		// Secondary and tertiary differences only count if all primary
+		// weights are equal.
+		{nil, "äa", "ab", -1},
+		{nil, "Äa", "äb", -1},
+		{nil, "Äa", "äa", +1},
+		{nil, "ä2", "a10", -1},
+		{nil, "æa", "aez", -1},
+		{nil, "æ", "ae", +1},
+		{[]Option{IgnoreAccents}, "æa", "aez", -1},
+		{[]Option{IgnoreAccents}, "Ä2", "ä10", -1},
+		{[]Option{IgnoreAccents}, "Ä", "ä", +1},
+		{[]Option{IgnoreCase}, "ä", "Ä", 0},
+		{[]Option{IgnoreCase}, "a", "Ä", -1},
+		{[]Option{Strength(Primary)}, "a", "Ä", 0},
+		{[]Option{Strength(Primary)}, "Ä", "b", -1},
+		{[]Option{Strength(Primary)}, "ä2", "a10", -1},
+		{[]Option{Strength(Secondary)}, "ä", "Ä", 0},
+		{[]Option{Strength(Secondary)}, "a", "Ä", -1},
+		{[]Option{Strength(Secondary)}, "ä2", "a10", -1},
+		{[]Option{Strength(Secondary)}, "äa", "ab", -1},
+		{[]Option{Strength(Secondary)}, "äa", "aa", +1},
+		{[]Option{Strength(Tertiary)}, "Äa", "äb", -1},
+		{[]Option{Strength(Tertiary)}, "äa", "Äa", -1},
+	}
+	for _, v := range testset {
+		c := NewCollator(append([]Option{rules}, v.opts...)...)
+		if got := c.Compare(v.s1, v.s2); got != v.cmp {
+			t.Errorf("Compared %#q to %#q: expected %v, got %v", v.s1, v.s2, v.cmp, got)
+		}
+	}
+}
+
+func TestCollatorTailorShared(t *testing.T) {
+	rules := MustTailor("&n < ñ")
+	c1, c2 := NewCollator(rules), NewCollator(rules, IgnoreCase)
+	if c1.tailoring != c2.tailoring {
+		t.Errorf("Collators with the same Tailor option don't share the tailoring")
+	}
+	// Combined rules get a tailoring of their own.
+	c3 := NewCollator(rules, MustTailor("&c < ch"))
+	if c3.tailoring == c1.tailoring {
+		t.Errorf("Collators with different rules share the tailoring")
+	}
+	for _, v := range [][2]string{{"nz", "ñ"}, {"ñ", "o"}, {"cz", "ch"}, {"ch", "d"}} {
+		if c3.Compare(v[0], v[1]) != -1 {
+			t.Errorf("Expected %#q < %#q", v[0], v[1])
+		}
+	}
+	if c1.Compare("ch", "d") != -1 || c1.Compare("cz", "ch") != +1 {
+		t.Errorf("Combining rules changed the shared tailoring")
+	}
+}
+
+func TestCollatorAlphabet(t *testing.T) {
+	c := NewCollator(MustAlphabet("z", "æ", "ø", "å"))
+	want := []string{"zebra", "ærø", "øl", "ål", "ål2", "ål10"}
+	got := []string{"ål10", "øl", "zebra", "ål", "ærø", "ål2"}
+	sort.Sort(c.Sorter(got))
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Error: sort failed, expected: %#q, got: %#q", want, got)
+	}
+
+	// Alphabets and rules are combined in order.
+	c = NewCollator(MustAlphabet("z", "ä", "ö"), MustTailor("&ö < ü"), MustAlphabet("", "c", "ch", "d"))
+	for _, v := range [][2]string{{"zz", "ä"}, {"ä", "ö"}, {"ö", "ü"}, {"cz", "ch"}, {"ch", "d"}} {
+		if c.Compare(v[0], v[1]) != -1 {
+			t.Errorf("Expected %#q < %#q", v[0], v[1])
+		}
+	}
+	if c := NewCollator(MustAlphabet("a")); !c.isDefault() {
+		t.Errorf("Alphabet with a single letter is not a no-op")
+	}
+	checkStrictWeakOrder(t, NewCollator(MustTailor("&a << ä <<< Ä < b &c < ch")),
+		[]string{"a", "A", "ä", "Ä", "ae", "b", "c", "C", "ch", "Ch", "CH", "cz", "d", "2", "a1"})
+}
+
+func TestTailorErrors(t *testing.T) {
+	for _, rules := range []string{
+		"",
+		"n < ñ",
+		"&n",
+		"&n <",
+		"&n < ñ &",
+		"&n ñ",
+		"&n < \xff",
+		"&1 < a",
+		"&a < b1",
+		"&a < \\1",
+		"&a < x١",
+		"&١ < a",
+		"&a < ０",
+	} {
+		if rules == "" {
+			if _, err := Tailor(rules); err != nil {
+				t.Errorf("Tailor(%+q): unexpected error: %v", rules, err)
+			}
+			continue
+		}
+		opt, err := Tailor(rules)
+		if err == nil || !strings.HasPrefix(err.Error(), "collate: ") {
+			t.Errorf("Tailor(%+q): expected error, got %v", rules, err)
+		}
+		// The returned Option has no effect.
+		if c := NewCollator(opt); !c.isDefault() {
+			t.Errorf("Tailor(%+q): the Option returned with the error changed the collator", rules)
+		}
+	}
+	for _, letters := range [][]string{
+		{"a", "x1"},
+		{"a", "x١"},
+		{"٢", "a"},
+		{"a", "\xff"},
+	} {
+		opt, err := Alphabet(letters...)
+		if err == nil || !strings.HasPrefix(err.Error(), "collate: ") {
+			t.Errorf("Alphabet(%+q): expected error, got %v", letters, err)
+		}
+		if c := NewCollator(opt); !c.isDefault() {
+			t.Errorf("Alphabet(%+q): the Option returned with the error changed the collator", letters)
+		}
+	}
+	for _, f := range []func(){
+		func() { MustTailor("n < ñ") },
+		func() { MustAlphabet("a", "x1") },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("MustTailor or MustAlphabet didn't panic")
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestCollatorTailorConcurrent(t *testing.T) {
+	c := NewCollator(MustTailor("&z < æ < ø < å"), IgnoreCase)
+	want := []string{"Zulu", "æble", "Øre", "ål 2", "Ål 10"}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				got := []string{"Ål 10", "Øre", "ål 2", "æble", "Zulu"}
+				sort.Sort(c.Sorter(got))
+				if !reflect.DeepEqual(want, got) {
+					t.Errorf("Error: sort failed, expected: %#q, got: %#q", want, got)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
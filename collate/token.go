@@ -0,0 +1,484 @@
+package collate
+
+import (
+	"cmp"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// A token is a single character or a number in a string being compared.
+type token struct {
+	number bool
+	// neg is true if the number has a negative sign.
+	neg bool
+	// r is the character, if this is not a number. Bytes that are not part of
+	// valid UTF-8 are represented as invalidRune + b, so they sort after all
+	// valid characters and are not equal to any of them.
+	r rune
+	// text is the number, as written, signText its sign (if any) and
+	// digitText the rest after the leading zeros.
+	text, signText, digitText string
+	// digits are the significant digits of the number,
+	// and frac the digits after the decimal point (if any).
+	digits, frac string
+	// ndigits is the number of significant digits, and zeros the number of
+	// leading zeros.
+	ndigits, zeros int
+	// version is true if the number is the first part of a version number,
+	// with DecimalFractions.
+	version bool
+	// w orders tailored characters after r.
+	w weight
+}
+
+// invalidRune is the base value used to represent invalid UTF-8 in tokens.
+const invalidRune = unicode.MaxRune + 1
+
+// A scanner splits a string into tokens.
+type scanner struct {
+	c   *Collator
+	str string
+	idx int
+	// pending holds the rest of a decomposed segment of str, and elems the
+	// rest of the elements of a tailored string.
+	pending string
+	elems   []element
+	// ignoreAccents is set if nonspacing marks are skipped, ignoreCase if
+	// characters are case folded, orderCase if characters are compared
+	// by their case first, and ignorePunct if white space, punctuation and
+	// symbols are skipped.
+	ignoreAccents, ignoreCase, orderCase, ignorePunct bool
+	// weights is the last level of tailored weights that are compared.
+	weights Level
+}
+
+// scanner returns a scanner for comparing str at the given level.
+func (c *Collator) scanner(str string, level Level) scanner {
+	return scanner{
+		c:             c,
+		str:           str,
+		ignoreAccents: c.ignoresAccents(level),
+		ignoreCase:    c.ignoresCase(level),
+		orderCase:     c.ordersCase(level),
+		ignorePunct:   c.ignoresPunct(level),
+		weights:       c.weightLevel(level),
+	}
+}
+
+// next returns the next token that is not ignored, or false at the end of
+// the string.
+func (s *scanner) next() (tok token, ok bool) {
+	for {
+		tok, ok = s.scan()
+		if !ok || tok.number || !s.ignored(tok.r) {
+			return tok, ok
+		}
+	}
+}
+
+// ignored reports whether the character r is skipped.
+func (s *scanner) ignored(r rune) bool {
+	if r == ignoredRune {
+		return true
+	}
+	if r == ' ' && s.c.space == ignoreSpace {
+		return true
+	}
+	if s.ignorePunct {
+		if class := charClass(r); class == SpaceClass || class == PunctClass || class == SymbolClass {
+			return true
+		}
+	}
+	return s.ignoreAccents && r >= utf8.RuneSelf && r < invalidRune && unicode.Is(unicode.Mn, r)
+}
+
+// scan returns the next token, or false at the end of the string.
+func (s *scanner) scan() (tok token, ok bool) {
+	if len(s.elems) > 0 {
+		tok.r, tok.w = s.fold(s.elems[0].r), s.elems[0].w
+		s.elems = s.elems[1:]
+		return tok, true
+	}
+	if s.pending != "" {
+		tok.r = s.fold(s.nextPending())
+		return tok, true
+	}
+	if s.idx >= len(s.str) {
+		return tok, false
+	}
+	r, size := s.peek(s.idx)
+	start := s.idx
+	if s.c.signed && isSign(r) && s.atBoundary(s.idx) {
+		if next, nextSize := s.peek(s.idx + size); s.isDigit(next) {
+			tok.neg = r != '+'
+			s.idx += size
+			r, size = next, nextSize
+		}
+	}
+	if !s.isDigit(r) {
+		if s.c.tailoring != nil {
+			if t, ok := s.c.tailoring.match(s.str[s.idx:]); ok {
+				s.idx += len(t.str)
+				s.elems = t.elems
+				return s.scan()
+			}
+		}
+		if s.c.space != keepSpace && unicode.IsSpace(r) {
+			return s.space()
+		}
+		if s.decomposes() && s.mayDecompose(r) {
+			r = s.decompose()
+		} else {
+			s.idx += size
+		}
+		tok.r = s.fold(r)
+		return tok, true
+	}
+
+	digitStart := s.idx
+	// Eat zeros.
+	for ; r == '0' || s.c.unicodeDigits && digitValue(r) == 0; r, size = s.peek(s.idx) {
+		s.idx += size
+		tok.zeros++
+	}
+	// Eat all digits.
+	nonZero := s.idx
+	for ; s.isDigit(r); r, size = s.peek(s.idx) {
+		s.idx += size
+		tok.ndigits++
+	}
+	tok.number = true
+	tok.digits = s.str[nonZero:s.idx]
+	if s.c.fractions && !s.afterVersion(digitStart) {
+		if end, version := s.fraction(s.idx); end > s.idx {
+			tok.frac = s.str[s.idx+1 : end]
+			s.idx = end
+		} else {
+			tok.version = version
+		}
+	}
+	if s.c.zeros == ZerosAsFraction && tok.zeros > 0 && tok.frac == "" {
+		// Compare all digits as the fractional part of zero.
+		tok.frac = s.str[digitStart:s.idx]
+		tok.digits, tok.ndigits, tok.zeros = "", 0, 0
+	}
+	tok.text = s.str[start:s.idx]
+	tok.signText = s.str[start:digitStart]
+	tok.digitText = s.str[nonZero:s.idx]
+	return tok, true
+}
+
+// space consumes a run of white space and returns it as a single ' '.
+// With CollapseSpace, runs at the start or end of the string are skipped.
+func (s *scanner) space() (tok token, ok bool) {
+	atStart := s.idx == 0
+	for r, size := s.peek(s.idx); unicode.IsSpace(r); r, size = s.peek(s.idx) {
+		s.idx += size
+	}
+	if s.c.space == collapseSpace && (atStart || s.idx >= len(s.str)) {
+		return s.scan()
+	}
+	tok.r = ' '
+	return tok, true
+}
+
+// fold maps r to its equivalent character, if any, and applies case folding
+// if case is ignored.
+func (s *scanner) fold(r rune) rune {
+	if s.c.runes != nil {
+		if mapped, ok := s.c.runes.m[r]; ok {
+			if mapped == ignoredRune {
+				return mapped
+			}
+			r = mapped
+		}
+	}
+	if s.ignoreCase {
+		return caseFold(r)
+	}
+	return r
+}
+
+// decomposes reports whether characters are decomposed before comparing.
+func (s *scanner) decomposes() bool {
+	return s.c.normalization != NoNormalization || s.c.ignoreAccents || s.c.strength != 0
+}
+
+// mayDecompose reports whether the character r at the current position may
+// start a segment that changes when it is decomposed. This is not the case
+// for ASCII followed by ASCII, or for invalid UTF-8.
+func (s *scanner) mayDecompose(r rune) bool {
+	if r >= invalidRune {
+		return false
+	}
+	return r >= utf8.RuneSelf || s.idx+1 < len(s.str) && s.str[s.idx+1] >= utf8.RuneSelf
+}
+
+// decompose consumes the segment at the current position, up to the next
+// normalization boundary, and returns the first character of its
+// decomposition. The rest of the decomposition is kept pending.
+func (s *scanner) decompose() rune {
+	form := norm.NFD
+	if s.c.normalization == Compatibility {
+		form = norm.NFKD
+	}
+	end := s.idx + form.NextBoundaryInString(s.str[s.idx:], true)
+	seg := s.str[s.idx:end]
+	s.idx = end
+	if !form.IsNormalString(seg) {
+		seg = form.String(seg)
+	}
+	s.pending = seg
+	return s.nextPending()
+}
+
+// nextPending removes the first character from s.pending and returns it.
+func (s *scanner) nextPending() rune {
+	r, size := utf8.DecodeRuneInString(s.pending)
+	if r == utf8.RuneError && size == 1 {
+		r = invalidRune + rune(s.pending[0])
+	}
+	s.pending = s.pending[size:]
+	return r
+}
+
+// fraction returns the end of the fractional part of a number that ends at
+// idx, or idx itself if there is no such part.
+// Fractional parts that are followed by another dot and more digits are
+// considered part of a version number, and are not returned either; version
+// reports whether that is the case.
+func (s *scanner) fraction(idx int) (end int, version bool) {
+	if !s.isDot(idx) {
+		return idx, false
+	}
+	end = idx + 1
+	for r, size := s.peek(end); s.isDigit(r); r, size = s.peek(end) {
+		end += size
+	}
+	if s.isDot(end) {
+		return idx, true
+	}
+	return end, false
+}
+
+// isDot reports whether idx holds a dot followed by a digit.
+func (s *scanner) isDot(idx int) bool {
+	if idx >= len(s.str) || s.str[idx] != '.' {
+		return false
+	}
+	r, _ := s.peek(idx + 1)
+	return s.isDigit(r)
+}
+
+// afterVersion reports whether a number starting at idx is preceded by
+// another number and a dot, which makes it part of a version number.
+func (s *scanner) afterVersion(idx int) bool {
+	if idx < 2 || s.str[idx-1] != '.' {
+		return false
+	}
+	r, size := utf8.DecodeLastRuneInString(s.str[:idx-1])
+	return size > 0 && s.isDigit(r)
+}
+
+// isSign reports whether r is a plus or minus sign.
+func isSign(r rune) bool {
+	return r == '-' || r == '+' || r == '\u2212'
+}
+
+// atBoundary reports whether idx is at the start of the string or follows a
+// character that is neither a letter nor a digit.
+func (s *scanner) atBoundary(idx int) bool {
+	if idx == 0 {
+		return true
+	}
+	r, _ := utf8.DecodeLastRuneInString(s.str[:idx])
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// peek decodes the character at idx, which may be the end of the string.
+func (s *scanner) peek(idx int) (r rune, size int) {
+	if idx >= len(s.str) {
+		return utf8.RuneError, 0
+	}
+	if c := s.str[idx]; c < utf8.RuneSelf {
+		return rune(c), 1
+	}
+	r, size = utf8.DecodeRuneInString(s.str[idx:])
+	if r == utf8.RuneError && size == 1 {
+		r = invalidRune + rune(s.str[idx])
+	}
+	return r, size
+}
+
+func (s *scanner) isDigit(r rune) bool {
+	if r < utf8.RuneSelf || !s.c.unicodeDigits {
+		return '0' <= r && r <= '9'
+	}
+	return unicode.Is(unicode.Nd, r)
+}
+
+// caseFold returns the lowest-numbered rune equivalent to the parameter.
+func caseFold(r rune) rune {
+	if r < utf8.RuneSelf {
+		// Upper-case ASCII is lower than lower-case ASCII.
+		if 'a' <= r && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		return r
+	}
+	// Iterate until SimpleFold returns a lower value.
+	// This will be the lowest-numbered equivalent rune.
+	var prev rune = -1
+	for r > prev {
+		prev, r = r, unicode.SimpleFold(r)
+	}
+	return r
+}
+
+// charClass returns the class of the character r.
+func charClass(r rune) CharClass {
+	switch {
+	case r >= invalidRune:
+		return OtherClass
+	case unicode.IsSpace(r):
+		return SpaceClass
+	case unicode.IsPunct(r):
+		return PunctClass
+	case unicode.IsSymbol(r):
+		return SymbolClass
+	case unicode.IsNumber(r):
+		return DigitClass
+	case unicode.IsLetter(r) || unicode.IsMark(r):
+		return LetterClass
+	}
+	return OtherClass
+}
+
+// digitValue returns the value of the decimal digit r (category Nd),
+// or -1 if r is not a decimal digit.
+func digitValue(r rune) int {
+	if '0' <= r && r <= '9' {
+		return int(r - '0')
+	}
+	// Decimal digits are encoded in contiguous ranges of ten, starting at
+	// zero, and the range table merges adjacent ranges.
+	for _, rng := range unicode.Nd.R16 {
+		if rune(rng.Lo) <= r && r <= rune(rng.Hi) {
+			return int(r-rune(rng.Lo)) % 10
+		}
+	}
+	for _, rng := range unicode.Nd.R32 {
+		if rune(rng.Lo) <= r && r <= rune(rng.Hi) {
+			return int(r-rune(rng.Lo)) % 10
+		}
+	}
+	return -1
+}
+
+// compareNumbers compares two numbers by value. If those are the same,
+// the leading zeros are compared according to the collator's ZeroPolicy,
+// and then the one with fewer trailing zeros after the decimal point is less.
+func (c *Collator) compareNumbers(t1, t2 *token) int {
+	// Negative numbers before zero before positive numbers.
+	sign1, sign2 := t1.sign(), t2.sign()
+	if sign1 != sign2 {
+		return cmp.Compare(sign1, sign2)
+	}
+	res := compareMagnitudes(t1, t2)
+	if sign1 < 0 {
+		// The larger the magnitude, the smaller the negative number.
+		res = -res
+	}
+	if res != 0 {
+		return res
+	}
+	// Otherwise, the leading zeros decide.
+	if t1.zeros != t2.zeros && c.zeros != ZerosIgnored {
+		if c.zeros == MoreZerosFirst {
+			return cmp.Compare(t2.zeros, t1.zeros)
+		}
+		return cmp.Compare(t1.zeros, t2.zeros)
+	}
+	// Finally, the one with the shorter fractional part is less.
+	return cmp.Compare(utf8.RuneCountInString(t1.frac), utf8.RuneCountInString(t2.frac))
+}
+
+// compareWritten compares the way two numbers with the same value are
+// written. With ZerosIgnored, their leading zeros are skipped.
+func (c *Collator) compareWritten(t1, t2 *token) int {
+	if c.zeros != ZerosIgnored {
+		return cmp.Compare(t1.text, t2.text)
+	}
+	if res := cmp.Compare(t1.signText, t2.signText); res != 0 {
+		return res
+	}
+	return cmp.Compare(t1.digitText, t2.digitText)
+}
+
+// hasFractions reports whether numbers can have a fractional part.
+func (c *Collator) hasFractions() bool {
+	return c.fractions || c.zeros == ZerosAsFraction
+}
+
+// sign returns the sign of the number: -1 if it is negative, 0 if it is zero,
+// and +1 if it is positive. Version numbers are never zero.
+func (t *token) sign() int {
+	if t.ndigits == 0 && !t.version {
+		zero := true
+		for _, r := range t.frac {
+			zero = zero && digitValue(r) == 0
+		}
+		if zero {
+			return 0
+		}
+	}
+	if t.neg {
+		return -1
+	}
+	return +1
+}
+
+// compareMagnitudes compares the absolute values of two numbers.
+func compareMagnitudes(t1, t2 *token) int {
+	// If lengths of numbers with non-zero prefix differ, the shorter
+	// one is less.
+	if t1.ndigits != t2.ndigits {
+		return cmp.Compare(t1.ndigits, t2.ndigits)
+	}
+	// If they're equally long, compare digit by digit.
+	if t1.digits != t2.digits {
+		d1, d2 := t1.digits, t2.digits
+		for len(d1) > 0 {
+			r1, size1 := utf8.DecodeRuneInString(d1)
+			r2, size2 := utf8.DecodeRuneInString(d2)
+			if v1, v2 := digitValue(r1), digitValue(r2); v1 != v2 {
+				return cmp.Compare(v1, v2)
+			}
+			d1, d2 = d1[size1:], d2[size2:]
+		}
+	}
+	// Version numbers are greater than decimal numbers with the same
+	// integer part.
+	if t1.version != t2.version {
+		if t1.version {
+			return +1
+		}
+		return -1
+	}
+	// Compare the fractional parts digit by digit, padding the shorter one
+	// with zeros.
+	if t1.frac != t2.frac {
+		f1, f2 := t1.frac, t2.frac
+		for len(f1) > 0 || len(f2) > 0 {
+			r1, size1 := utf8.DecodeRuneInString(f1)
+			r2, size2 := utf8.DecodeRuneInString(f2)
+			if v1, v2 := max(digitValue(r1), 0), max(digitValue(r2), 0); v1 != v2 {
+				return cmp.Compare(v1, v2)
+			}
+			f1, f2 = f1[size1:], f2[size2:]
+		}
+	}
+	return 0
+}
@@ -2,4 +2,7 @@
 //
 // Currently, it only implements so-called "natural order", where integers
 // embedded in strings are compared by value.
+// NaturalLess and NaturalCompare implement the common case efficiently,
+// while the Collator of the collate sub-package can be configured to vary
+// the details.
 package sortorder // import "github.com/fvbommel/sortorder"
@@ -1,3 +1,5 @@
 module github.com/fvbommel/sortorder
 
-go 1.13
+go 1.21
+
+require golang.org/x/text v0.22.0
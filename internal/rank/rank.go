@@ -0,0 +1,72 @@
+// Package rank implements the character ranks shared by the collate and
+// casefolded packages, so both order case and scripts the same way.
+package rank // import "github.com/fvbommel/sortorder/internal/rank"
+
+import (
+	"slices"
+	"unicode"
+)
+
+// Case returns 0 for characters that come first when ordering by case, and
+// 1 for all others. Lower-case letters come first if lowerFirst is set, and
+// upper-case and title-case letters otherwise.
+func Case(r rune, lowerFirst bool) int {
+	if lowerFirst {
+		if unicode.IsLower(r) {
+			return 0
+		}
+	} else if unicode.IsUpper(r) || unicode.IsTitle(r) {
+		return 0
+	}
+	return 1
+}
+
+// MaxScripts is the maximum number of scripts used, so ranks fit in a key
+// byte.
+const MaxScripts = 250
+
+// Scripts returns the scripts of tables that are used by Script: nil tables
+// and tables that occur earlier are skipped, and only the first MaxScripts
+// tables are kept. If there is nothing to skip, the result is tables itself.
+func Scripts(tables []*unicode.RangeTable) []*unicode.RangeTable {
+	for i, table := range tables {
+		if i == MaxScripts {
+			return tables[:i]
+		}
+		if table == nil || slices.Contains(tables[:i], table) {
+			return clean(tables)
+		}
+	}
+	return tables
+}
+
+// clean returns a copy of tables without nil tables and duplicates.
+func clean(tables []*unicode.RangeTable) []*unicode.RangeTable {
+	var scripts []*unicode.RangeTable
+	for _, table := range tables {
+		if table != nil && !slices.Contains(scripts, table) && len(scripts) < MaxScripts {
+			scripts = append(scripts, table)
+		}
+	}
+	return scripts
+}
+
+// Script returns 0 for characters without a specific script, or no scripts
+// at all, the position plus one of the first script of r in scripts, or
+// len(scripts)+1 if it isn't there. The scripts must be the result of
+// Scripts. Characters beyond unicode.MaxRune, which some callers use for
+// invalid UTF-8, have no specific script.
+func Script(scripts []*unicode.RangeTable, r rune) int {
+	if len(scripts) == 0 || r > unicode.MaxRune {
+		return 0
+	}
+	for i, table := range scripts {
+		if unicode.Is(table, r) {
+			return i + 1
+		}
+	}
+	if unicode.Is(unicode.Common, r) {
+		return 0
+	}
+	return len(scripts) + 1
+}
@@ -0,0 +1,74 @@
+package rank
+
+import (
+	"slices"
+	"testing"
+	"unicode"
+)
+
+func TestCase(t *testing.T) {
+	testset := []struct {
+		r          rune
+		lowerFirst bool
+		want       int
+	}{
+		{'a', true, 0},
+		{'A', true, 1},
+		{'ǅ', true, 1},
+		{'1', true, 1},
+		{'a', false, 1},
+		{'A', false, 0},
+		{'ǅ', false, 0},
+		{'1', false, 1},
+	}
+	for _, v := range testset {
+		if got := Case(v.r, v.lowerFirst); got != v.want {
+			t.Errorf("Case(%q, %v): expected %v, got %v", v.r, v.lowerFirst, v.want, got)
+		}
+	}
+}
+
+func TestScripts(t *testing.T) {
+	tables := []*unicode.RangeTable{unicode.Greek, unicode.Latin}
+	if got := Scripts(tables); &got[0] != &tables[0] || len(got) != 2 {
+		t.Errorf("Scripts(%v): expected the tables themselves, got %v", tables, got)
+	}
+	got := Scripts([]*unicode.RangeTable{nil, unicode.Greek, nil, unicode.Greek, unicode.Latin})
+	if !slices.Equal(got, tables) {
+		t.Errorf("Scripts with nil and duplicate tables: expected %v, got %v", tables, got)
+	}
+	many := make([]*unicode.RangeTable, 0, 2*MaxScripts)
+	for i := 0; i < 2*MaxScripts; i++ {
+		many = append(many, &unicode.RangeTable{})
+	}
+	if got := Scripts(many); len(got) != MaxScripts {
+		t.Errorf("Scripts: expected %v tables, got %v", MaxScripts, len(got))
+	}
+	if got := Scripts(append([]*unicode.RangeTable{nil}, many...)); len(got) != MaxScripts || got[0] != many[0] {
+		t.Errorf("Scripts: expected the first %v tables", MaxScripts)
+	}
+}
+
+func TestScript(t *testing.T) {
+	scripts := []*unicode.RangeTable{unicode.Greek, unicode.Latin}
+	testset := []struct {
+		scripts []*unicode.RangeTable
+		r       rune
+		want    int
+	}{
+		{scripts, 'α', 1},
+		{scripts, 'a', 2},
+		{scripts, 'б', 3},
+		{scripts, '́', 3},
+		{scripts, ' ', 0},
+		{scripts, '1', 0},
+		{scripts, unicode.MaxRune + 1, 0},
+		{nil, 'б', 0},
+		{[]*unicode.RangeTable{unicode.Common}, ' ', 1},
+	}
+	for _, v := range testset {
+		if got := Script(v.scripts, v.r); got != v.want {
+			t.Errorf("Script(%v, %q): expected %v, got %v", v.scripts, v.r, v.want, got)
+		}
+	}
+}
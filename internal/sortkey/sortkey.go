@@ -0,0 +1,28 @@
+// Package sortkey implements the parts of the sort key encoding shared by
+// sortorder.AppendKey and the sort keys of the sub-packages.
+package sortkey // import "github.com/fvbommel/sortorder/internal/sortkey"
+
+// Number introduces a number in a sort key.
+// It is less than any encoded character, so numbers sort before other
+// characters.
+const Number = 0x01
+
+// AppendNumber appends the encoding of a number with the given significant
+// digits and number of leading zeros: Number, then the number of
+// significant digits as a count, then the digits themselves and finally the
+// number of leading zeros as a count.
+func AppendNumber(dst []byte, digits string, zeros int) []byte {
+	dst = append(dst, Number)
+	dst = AppendCount(dst, len(digits))
+	dst = append(dst, digits...)
+	return AppendCount(dst, zeros)
+}
+
+// AppendCount appends the order-preserving encoding of the count n:
+// n/255 bytes 0xFF followed by the single byte n%255.
+func AppendCount(dst []byte, n int) []byte {
+	for ; n >= 0xFF; n -= 0xFF {
+		dst = append(dst, 0xFF)
+	}
+	return append(dst, byte(n))
+}
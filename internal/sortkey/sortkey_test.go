@@ -0,0 +1,22 @@
+package sortkey
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestAppendCount(t *testing.T) {
+	counts := []int{0, 1, 2, 0xFE, 0xFF, 0x100, 2*0xFF - 1, 2 * 0xFF, 1000}
+	for i := 1; i < len(counts); i++ {
+		k1, k2 := AppendCount(nil, counts[i-1]), AppendCount(nil, counts[i])
+		if bytes.Compare(k1, k2) >= 0 {
+			t.Errorf("AppendCount(%v) = %x is not less than AppendCount(%v) = %x", counts[i-1], k1, counts[i], k2)
+		}
+	}
+}
+
+func TestAppendNumber(t *testing.T) {
+	if got, want := AppendNumber([]byte("x"), "12", 1), []byte("x\x01\x0212\x01"); !bytes.Equal(got, want) {
+		t.Errorf("AppendNumber: expected %x, got %x", want, got)
+	}
+}
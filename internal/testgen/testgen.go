@@ -0,0 +1,31 @@
+// Package testgen generates random strings for the tests of sortorder and
+// its sub-packages.
+package testgen // import "github.com/fvbommel/sortorder/internal/testgen"
+
+import "math/rand"
+
+// A Generator generates random strings from the pieces of an alphabet.
+type Generator struct {
+	alphabet []string
+	src      *rand.Rand
+}
+
+// New returns a Generator of strings made of the given pieces. Its seed is
+// fixed, so tests fail the same way every time.
+func New(alphabet ...string) *Generator {
+	return &Generator{alphabet, rand.New(rand.NewSource(300))}
+}
+
+// Next returns a random string of up to 7 pieces.
+func (g *Generator) Next() string {
+	return g.NextN(0, 7)
+}
+
+// NextN returns a random string of min to max pieces.
+func (g *Generator) NextN(min, max int) string {
+	var s string
+	for n := min + g.src.Intn(max-min+1); n > 0; n-- {
+		s += g.alphabet[g.src.Intn(len(g.alphabet))]
+	}
+	return s
+}
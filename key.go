@@ -0,0 +1,59 @@
+package sortorder
+
+import "github.com/fvbommel/sortorder/internal/sortkey"
+
+// KeyVersion is the version of the sort key format produced by AppendKey.
+//
+// Keys are meant to be stored, e.g. in database indexes, so the format will
+// not change without this version being incremented. Keys created with
+// different versions must not be compared with each other.
+const KeyVersion = 1
+
+// Key returns a sort key for s. See AppendKey for details.
+func Key(s string) []byte {
+	return AppendKey(nil, s)
+}
+
+// AppendKey appends a sort key for s to dst and returns the extended buffer.
+//
+// Sort keys compare bytewise in natural order: for any strings a and b,
+// bytes.Compare(Key(a), Key(b)) == NaturalCompare(a, b). This makes them
+// suitable for storage systems that can only order by bytes, such as
+// key-value stores and binary database columns.
+//
+// The key format (version 1, see KeyVersion) is a concatenation of
+// encoded chunks, without any terminator:
+//   - a run of digits is encoded as 0x01, then the number of significant
+//     digits as a count, then the significant digits themselves and finally
+//     the number of leading zeros as a count.
+//   - any other byte b is encoded as b+2 if b <= 0xFC, and as the two bytes
+//     0xFF, b-0xFD otherwise. (The latter can't occur in valid UTF-8.)
+//
+// A count n is encoded as n/255 bytes 0xFF followed by the single byte n%255.
+func AppendKey(dst []byte, s string) []byte {
+	for idx := 0; idx < len(s); {
+		if c := s[idx]; !isDigit(c) {
+			dst = appendKeyByte(dst, c)
+			idx++
+			continue
+		}
+		// Eat zeros.
+		start := idx
+		for ; idx < len(s) && s[idx] == '0'; idx++ {
+		}
+		// Eat all digits.
+		nonZero := idx
+		for ; idx < len(s) && isDigit(s[idx]); idx++ {
+		}
+		dst = sortkey.AppendNumber(dst, s[nonZero:idx], nonZero-start)
+	}
+	return dst
+}
+
+// appendKeyByte appends the sort key encoding of the non-digit byte c.
+func appendKeyByte(dst []byte, c byte) []byte {
+	if c <= 0xFC {
+		return append(dst, c+2)
+	}
+	return append(dst, 0xFF, c-0xFD)
+}
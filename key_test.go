@@ -0,0 +1,71 @@
+package sortorder
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fvbommel/sortorder/internal/testgen"
+)
+
+func TestKey(t *testing.T) {
+	testset := []struct {
+		s1, s2 string
+	}{
+		{"", "0"},
+		{"0", "00"},
+		{"ab12", "abc"},
+		{"ab2a", "ab10"},
+		{"a01b001", "a001b01"},
+		{"9a", "083a"},
+		{"a\x00", "a0"},
+		{"a\x01", "a\x02"},
+		{"\xfc", "\xfd"},
+		{"\xfd\x00", "\xfe"},
+		{"\xfe", "\xff"},
+	}
+	for _, v := range testset {
+		checkKeys(t, v.s1, v.s2)
+		checkKeys(t, v.s2, v.s1)
+	}
+
+	// Numbers longer than a single count byte can represent.
+	long := string(bytes.Repeat([]byte{'9'}, 300))
+	checkKeys(t, "a"+long, "a1"+long)
+	checkKeys(t, "a"+long, "a0"+long)
+}
+
+func TestKeyRandom(t *testing.T) {
+	// Mix digits, letters and the bytes that need escaping.
+	gen := testgen.New("0", "0", "1", "2", "3", "8", "9", "a", "b", "\x00", "\x01", "\x02", "\xfc", "\xfd", "\xfe", "\xff")
+	for i := 0; i < 10000; i++ {
+		checkKeys(t, gen.Next(), gen.Next())
+	}
+}
+
+func TestAppendKey(t *testing.T) {
+	prefix := []byte("prefix")
+	got := AppendKey(prefix, "abc10")
+	if want := append([]byte("prefix"), Key("abc10")...); !bytes.Equal(got, want) {
+		t.Errorf("AppendKey(%q, %#q) = %q, expected %q", prefix, "abc10", got, want)
+	}
+}
+
+func checkKeys(t *testing.T, s1, s2 string) {
+	t.Helper()
+	k1, k2 := Key(s1), Key(s2)
+	if got, want := bytes.Compare(k1, k2), NaturalCompare(s1, s2); got != want {
+		t.Errorf("Compared keys of %#q and %#q: expected %v, got %v (keys %x and %x)",
+			s1, s2, want, got, k1, k2)
+	}
+}
+
+func BenchmarkKey(b *testing.B) {
+	set := testSet(300)
+	var buf []byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, s := range set[0] {
+			buf = AppendKey(buf[:0], s)
+		}
+	}
+}
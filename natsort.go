@@ -1,5 +1,7 @@
 package sortorder
 
+import "cmp"
+
 // Natural implements sort.Interface to sort strings in natural order. This
 // means that e.g. "abc2" < "abc12".
 //
@@ -8,6 +10,7 @@ package sortorder
 // the number of leading zeros is used as a tie-breaker, so e.g. "2" < "02")
 //
 // Limitation: only ASCII digits (0-9) are considered.
+// Use a collate.Collator with the UnicodeDigits option to support other digits.
 type Natural []string
 
 func (n Natural) Len() int           { return len(n) }
@@ -24,19 +27,34 @@ func isDigit(b byte) bool { return '0' <= b && b <= '9' }
 // the number of leading zeros is used as a tie-breaker, so e.g. "2" < "02")
 //
 // Limitation: only ASCII digits (0-9) are considered.
+// Use a collate.Collator with the UnicodeDigits option to support other digits.
 func NaturalLess(str1, str2 string) bool {
+	return NaturalCompare(str1, str2) < 0
+}
+
+// NaturalCompare compares two strings using natural ordering, like NaturalLess.
+// The result will be 0 if str1 == str2, -1 if str1 < str2, and +1 if str1 > str2
+// in natural order, which makes it suitable for use with functions like
+// slices.SortFunc and slices.BinarySearchFunc.
+//
+// NaturalCompare(str1, str2) < 0 if and only if NaturalLess(str1, str2).
+// Note that only identical strings compare as equal.
+func NaturalCompare(str1, str2 string) int {
 	idx1, idx2 := 0, 0
 	for idx1 < len(str1) && idx2 < len(str2) {
 		c1, c2 := str1[idx1], str2[idx2]
 		dig1, dig2 := isDigit(c1), isDigit(c2)
 		switch {
 		case dig1 != dig2: // Digits before other characters.
-			return dig1 // True if LHS is a digit, false if the RHS is one.
+			if dig1 { // LHS is a digit, so it's less.
+				return -1
+			}
+			return +1
 		case !dig1: // && !dig2, because dig1 == dig2
 			// UTF-8 compares bytewise-lexicographically, no need to decode
 			// codepoints.
 			if c1 != c2 {
-				return c1 < c2
+				return cmp.Compare(c1, c2)
 			}
 			idx1++
 			idx2++
@@ -55,22 +73,22 @@ func NaturalLess(str1, str2 string) bool {
 			// If lengths of numbers with non-zero prefix differ, the shorter
 			// one is less.
 			if len1, len2 := idx1-nonZero1, idx2-nonZero2; len1 != len2 {
-				return len1 < len2
+				return cmp.Compare(len1, len2)
 			}
 			// If they're equally long, string comparison is correct.
 			if nr1, nr2 := str1[nonZero1:idx1], str2[nonZero2:idx2]; nr1 != nr2 {
-				return nr1 < nr2
+				return cmp.Compare(nr1, nr2)
 			}
 			// Otherwise, the one with less zeros is less.
 			// Because everything up to the number is equal, comparing the index
 			// after the zeros is sufficient.
 			if nonZero1 != nonZero2 {
-				return nonZero1 < nonZero2
+				return cmp.Compare(nonZero1, nonZero2)
 			}
 		}
 		// They're identical so far, so continue comparing.
 	}
 	// So far they are identical. At least one is ended. If the other continues,
 	// it sorts last.
-	return len(str1) < len(str2)
+	return cmp.Compare(len(str1), len(str2))
 }
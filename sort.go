@@ -0,0 +1,36 @@
+package sortorder
+
+import "slices"
+
+// Sort sorts a slice of strings in natural order (see NaturalLess).
+// The elements may be of any string type, so e.g. a []MyID can be sorted
+// without converting it to a []string first.
+//
+// The sort is not guaranteed to be stable, but since only identical strings
+// compare as equal in natural order this makes no observable difference.
+func Sort[S ~[]E, E ~string](s S) {
+	slices.SortFunc(s, func(a, b E) int {
+		return NaturalCompare(string(a), string(b))
+	})
+}
+
+// SortByKey sorts a slice in the natural order of the keys returned by the
+// key function (see NaturalLess). The key function is called for both
+// elements of every comparison, so it should be cheap.
+//
+// The sort is not guaranteed to be stable: elements with identical keys may
+// be reordered. Use SortStableByKey if that matters.
+func SortByKey[S ~[]E, E any](s S, key func(E) string) {
+	slices.SortFunc(s, func(a, b E) int {
+		return NaturalCompare(key(a), key(b))
+	})
+}
+
+// SortStableByKey sorts a slice in the natural order of the keys returned by
+// the key function (see NaturalLess), while keeping elements with identical
+// keys in their original order.
+func SortStableByKey[S ~[]E, E any](s S, key func(E) string) {
+	slices.SortStableFunc(s, func(a, b E) int {
+		return NaturalCompare(key(a), key(b))
+	})
+}
@@ -0,0 +1,49 @@
+package sortorder
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testID string
+
+func TestSort(t *testing.T) {
+	want := []testID{"ab", "abc1", "abc01", "abc2", "abc5", "abc10"}
+	got := []testID{"abc5", "abc1", "abc01", "ab", "abc10", "abc2"}
+	Sort(got)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Error: sort failed, expected: %#q, got: %#q", want, got)
+	}
+}
+
+func TestSortByKey(t *testing.T) {
+	type host struct {
+		name string
+		idx  int
+	}
+	hosts := []host{
+		{"web10", 0}, {"db2", 1}, {"web2", 2},
+		{"web10", 3}, {"db10", 4}, {"web2", 5},
+	}
+	name := func(h host) string { return h.name }
+
+	want := []host{
+		{"db2", 1}, {"db10", 4},
+		{"web2", 2}, {"web2", 5},
+		{"web10", 0}, {"web10", 3},
+	}
+	got := append([]host(nil), hosts...)
+	SortStableByKey(got, name)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Error: stable sort failed, expected: %v, got: %v", want, got)
+	}
+
+	got = append([]host(nil), hosts...)
+	SortByKey(got, name)
+	for i := range got {
+		if got[i].name != want[i].name {
+			t.Errorf("Error: sort failed, expected: %v, got: %v", want, got)
+			break
+		}
+	}
+}